@@ -18,8 +18,15 @@ package options
 
 import (
 	"fmt"
+	"strings"
+	"time"
+
 	"github.com/spf13/pflag"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/util/errors"
+	"k8s.io/client-go/tools/leaderelection/resourcelock"
+	componentbaseconfig "k8s.io/component-base/config"
+	componentbaseoptions "k8s.io/component-base/config/options"
 )
 
 const (
@@ -27,6 +34,15 @@ const (
 	defaultPort          = 8443
 	defaultCertDir       = "/tmp/k8s-webhook-server/serving-certs"
 	defaultTLSMinVersion = "1.3"
+
+	defaultLeaderElectResourceName      = "hami-dra-webhook"
+	defaultLeaderElectResourceNamespace = "kube-system"
+)
+
+var (
+	defaultLeaseDuration = 15 * time.Second
+	defaultRenewDeadline = 10 * time.Second
+	defaultRetryPeriod   = 2 * time.Second
 )
 
 // Options contains everything necessary to create and run webhook server.
@@ -62,15 +78,36 @@ type Options struct {
 	HealthProbeBindAddress string
 	// DeviceConfigFile is the path to the device config file.
 	DeviceConfigFile string
+	// StrictDeviceConfig rejects device config files containing unknown fields.
+	StrictDeviceConfig bool
+	// LeaderElection defines the configuration of leader election client.
+	// Only the controllers are subject to leader election, webhooks are served by every replica.
+	LeaderElection componentbaseconfig.LeaderElectionConfiguration
+	// Controllers is the list of controllers to enable or disable.
+	// '*' means "all enabled by default controllers"
+	// 'foo' means "enable 'foo'"
+	// '-foo' means "disable 'foo'"
+	// first item for a particular name wins
+	Controllers []string
 }
 
-// NewOptions builds an empty options.
+// NewOptions builds an default options.
 func NewOptions() *Options {
-	return &Options{}
+	return &Options{
+		LeaderElection: componentbaseconfig.LeaderElectionConfiguration{
+			LeaderElect:       true,
+			LeaseDuration:     metav1.Duration{Duration: defaultLeaseDuration},
+			RenewDeadline:     metav1.Duration{Duration: defaultRenewDeadline},
+			RetryPeriod:       metav1.Duration{Duration: defaultRetryPeriod},
+			ResourceLock:      resourcelock.LeasesResourceLock,
+			ResourceName:      defaultLeaderElectResourceName,
+			ResourceNamespace: defaultLeaderElectResourceNamespace,
+		},
+	}
 }
 
 // AddFlags adds flags to the specified FlagSet.
-func (o *Options) AddFlags(flags *pflag.FlagSet) {
+func (o *Options) AddFlags(flags *pflag.FlagSet, allControllers []string) {
 	flags.StringVar(&o.BindAddress, "bind-address", defaultBindAddress,
 		"The IP address on which to listen for the --secure-port port.")
 	flags.IntVar(&o.SecurePort, "secure-port", defaultPort,
@@ -85,6 +122,12 @@ func (o *Options) AddFlags(flags *pflag.FlagSet) {
 	flags.StringVar(&o.MetricsBindAddress, "metrics-bind-address", ":8080", "The TCP address that the controller should bind to for serving prometheus metrics(e.g. 127.0.0.1:8080, :8080). It can be set to \"0\" to disable the metrics serving.")
 	flags.StringVar(&o.HealthProbeBindAddress, "health-probe-bind-address", ":8000", "The TCP address that the controller should bind to for serving health probes(e.g. 127.0.0.1:8000, :8000)")
 	flags.StringVar(&o.DeviceConfigFile, "device-config-file", "device-config.yaml", "The path to the device config file.")
+	flags.BoolVar(&o.StrictDeviceConfig, "strict-device-config", false, "Reject device config files containing unknown fields instead of ignoring them.")
+	flags.StringSliceVar(&o.Controllers, "controllers", []string{"*"}, fmt.Sprintf(
+		"A list of controllers to enable. '*' enables all on-by-default controllers, 'foo' enables the controller named 'foo', '-foo' disables the controller named 'foo'. All controllers: %s.",
+		strings.Join(allControllers, ", "),
+	))
+	componentbaseoptions.BindLeaderElectionFlags(&o.LeaderElection, flags)
 }
 
 // Validate validates the options and returns aggregated errors.
@@ -99,5 +142,20 @@ func (o *Options) Validate() error {
 		errs = append(errs, fmt.Errorf("--tls-min-version must be one of: 1.0, 1.1, 1.2, 1.3"))
 	}
 
+	if o.LeaderElection.LeaderElect {
+		if o.LeaderElection.ResourceLock != resourcelock.LeasesResourceLock {
+			errs = append(errs, fmt.Errorf("--leader-elect-resource-lock must be %q", resourcelock.LeasesResourceLock))
+		}
+		if o.LeaderElection.LeaseDuration.Duration <= 0 || o.LeaderElection.RenewDeadline.Duration <= 0 || o.LeaderElection.RetryPeriod.Duration <= 0 {
+			errs = append(errs, fmt.Errorf("--leader-elect-lease-duration, --leader-elect-renew-deadline and --leader-elect-retry-period must be greater than zero"))
+		} else if o.LeaderElection.RenewDeadline.Duration >= o.LeaderElection.LeaseDuration.Duration {
+			errs = append(errs, fmt.Errorf("--leader-elect-renew-deadline %v must be less than --leader-elect-lease-duration %v",
+				o.LeaderElection.RenewDeadline.Duration, o.LeaderElection.LeaseDuration.Duration))
+		}
+		if o.LeaderElection.ResourceName == "" || o.LeaderElection.ResourceNamespace == "" {
+			errs = append(errs, fmt.Errorf("--leader-elect-resource-name and --leader-elect-resource-namespace must not be empty"))
+		}
+	}
+
 	return errors.NewAggregate(errs)
 }
@@ -0,0 +1,64 @@
+/*
+Copyright 2025 The HAMi Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package app
+
+import (
+	"github.com/spf13/cobra"
+
+	"github.com/Project-HAMi/HAMi-DRA/pkg/config"
+)
+
+var printDefaultsExample = `  # Print the built-in defaults
+  webhook print-defaults
+
+  # Print the effective config the webhook would use for a device config file
+  webhook print-defaults --device-config-file=device-config.yaml`
+
+// NewPrintDefaultsCommand creates a command that prints the effective device config.
+func NewPrintDefaultsCommand() *cobra.Command {
+	var deviceConfigFile string
+
+	cmd := &cobra.Command{
+		Use:     "print-defaults",
+		Short:   "Print the effective device config",
+		Long:    `Print the device config the webhook would use, with defaults applied to every unset field.`,
+		Example: printDefaultsExample,
+		Args:    cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			deviceConfig := &config.Config{}
+			if deviceConfigFile == "" {
+				config.SetDefaults(deviceConfig)
+			} else {
+				// The loaded config already has the defaults applied.
+				var err error
+				if deviceConfig, err = config.LoadFile(deviceConfigFile, false); err != nil {
+					return err
+				}
+			}
+
+			data, err := config.MarshalConfig(deviceConfig)
+			if err != nil {
+				return err
+			}
+			_, err = cmd.OutOrStdout().Write(data)
+			return err
+		},
+	}
+
+	cmd.Flags().StringVar(&deviceConfigFile, "device-config-file", "", "The path to the device config file, the built-in defaults are printed if empty.")
+	return cmd
+}
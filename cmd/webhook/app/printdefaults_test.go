@@ -0,0 +1,102 @@
+/*
+Copyright 2025 The HAMi Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package app
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/Project-HAMi/HAMi-DRA/pkg/config"
+)
+
+func TestPrintDefaultsCommand(t *testing.T) {
+	tests := []struct {
+		Name             string
+		Content          string
+		ExpectError      bool
+		ExpectCountName  string
+		ExpectMemoryName string
+		ExpectMemoryUnit string
+	}{
+		{
+			Name:             "built-in defaults",
+			ExpectCountName:  config.DefaultResourceCountName,
+			ExpectMemoryName: config.DefaultResourceMemoryName,
+			ExpectMemoryUnit: config.DefaultCambriconMemoryUnit,
+		},
+		{
+			Name:             "file with defaults filled",
+			Content:          "nvidia:\n  resourceCountName: hami.io/gpu\n",
+			ExpectCountName:  "hami.io/gpu",
+			ExpectMemoryName: config.DefaultResourceMemoryName,
+			ExpectMemoryUnit: config.DefaultCambriconMemoryUnit,
+		},
+		{
+			Name:        "invalid file",
+			Content:     "nvidia:\n  resourceCountName: nvidia.com/gpu/invalid\n",
+			ExpectError: true,
+		},
+	}
+
+	for i := range tests {
+		tc := tests[i]
+
+		t.Run(tc.Name, func(t *testing.T) {
+			var args []string
+			if tc.Content != "" {
+				path := filepath.Join(t.TempDir(), "device-config.yaml")
+				if err := os.WriteFile(path, []byte(tc.Content), 0o644); err != nil {
+					t.Fatal(err)
+				}
+				args = append(args, "--device-config-file="+path)
+			}
+			out := &bytes.Buffer{}
+			cmd := NewPrintDefaultsCommand()
+			cmd.SetOut(out)
+			cmd.SetErr(&bytes.Buffer{})
+			cmd.SetArgs(args)
+
+			err := cmd.Execute()
+			if tc.ExpectError {
+				if err == nil {
+					t.Fatalf("Expect error, but got nil")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("No error is expected but got: %v", err)
+			}
+
+			// The printed config is a valid device config the webhook loads as is.
+			printed, err := config.Parse(out.Bytes(), true)
+			if err != nil {
+				t.Fatalf("No error is expected but got: %v", err)
+			}
+			if printed.Nvidia.ResourceCountName != tc.ExpectCountName {
+				t.Fatalf("expect resourceCountName: %s, but got: %s", tc.ExpectCountName, printed.Nvidia.ResourceCountName)
+			}
+			if printed.Nvidia.ResourceMemoryName != tc.ExpectMemoryName {
+				t.Fatalf("expect resourceMemoryName: %s, but got: %s", tc.ExpectMemoryName, printed.Nvidia.ResourceMemoryName)
+			}
+			if printed.Cambricon.MemoryUnit != tc.ExpectMemoryUnit {
+				t.Fatalf("expect cambricon memoryUnit: %s, but got: %s", tc.ExpectMemoryUnit, printed.Cambricon.MemoryUnit)
+			}
+		})
+	}
+}
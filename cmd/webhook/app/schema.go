@@ -0,0 +1,46 @@
+/*
+Copyright 2025 The HAMi Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package app
+
+import (
+	"github.com/spf13/cobra"
+
+	"github.com/Project-HAMi/HAMi-DRA/pkg/config"
+)
+
+var schemaExample = `  # Print the JSON Schema of the device config
+  webhook schema > device-config.schema.json`
+
+// NewSchemaCommand creates a command that prints the JSON Schema of the device config.
+func NewSchemaCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "schema",
+		Short:   "Print the JSON Schema of the device config",
+		Long:    `Print the JSON Schema of the device config, for editors and CI pipelines validating device config files.`,
+		Example: schemaExample,
+		Args:    cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			data, err := config.MarshalSchema()
+			if err != nil {
+				return err
+			}
+			_, err = cmd.OutOrStdout().Write(data)
+			return err
+		},
+	}
+	return cmd
+}
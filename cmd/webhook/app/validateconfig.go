@@ -0,0 +1,94 @@
+/*
+Copyright 2025 The HAMi Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package app
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/spf13/cobra"
+	"gopkg.in/yaml.v3"
+
+	"github.com/Project-HAMi/HAMi-DRA/pkg/config"
+)
+
+var validateConfigExample = `  # Validate a device config before rolling it out
+  webhook validate-config --device-config-file=device-config.yaml`
+
+// NewValidateConfigCommand creates a command that reports every problem found in a device config file.
+func NewValidateConfigCommand() *cobra.Command {
+	var deviceConfigFile string
+	var strict bool
+
+	cmd := &cobra.Command{
+		Use:     "validate-config",
+		Short:   "Validate a device config file",
+		Long:    `Parse a device config file, check its semantic rules and print every problem found.`,
+		Example: validateConfigExample,
+		Args:    cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			data, err := os.ReadFile(deviceConfigFile)
+			if err != nil {
+				return err
+			}
+			problems := validateConfig(data, strict)
+			return reportProblems(cmd.OutOrStdout(), deviceConfigFile, problems)
+		},
+	}
+
+	cmd.Flags().StringVar(&deviceConfigFile, "device-config-file", "device-config.yaml", "The path to the device config file.")
+	cmd.Flags().BoolVar(&strict, "strict", true, "Report unknown fields, such as typos, as problems.")
+	return cmd
+}
+
+// validateConfig returns every decoding and semantic problem of the device config.
+func validateConfig(data []byte, strict bool) []string {
+	var problems []string
+
+	if _, err := config.UnmarshalConfig(data, strict); err != nil {
+		var typeErr *yaml.TypeError
+		if !errors.As(err, &typeErr) {
+			// Syntax errors leave nothing to check further.
+			return append(problems, err.Error())
+		}
+		problems = append(problems, typeErr.Errors...)
+	}
+
+	// Check the semantic rules on everything that could be decoded.
+	deviceConfig, err := config.UnmarshalConfig(data, false)
+	if err != nil {
+		return problems
+	}
+	config.SetDefaults(deviceConfig)
+	for _, fieldErr := range config.Validate(deviceConfig) {
+		problems = append(problems, fieldErr.Error())
+	}
+	return problems
+}
+
+func reportProblems(out io.Writer, path string, problems []string) error {
+	if len(problems) == 0 {
+		fmt.Fprintf(out, "%s is valid\n", path)
+		return nil
+	}
+	for _, problem := range problems {
+		fmt.Fprintf(out, "- %s\n", problem)
+	}
+	return fmt.Errorf("%s has %d problem(s)", path, len(problems))
+}
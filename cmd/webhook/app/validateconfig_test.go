@@ -0,0 +1,113 @@
+/*
+Copyright 2025 The HAMi Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package app
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestValidateConfigCommand(t *testing.T) {
+	tests := []struct {
+		Name           string
+		Content        string
+		Args           []string
+		ExpectError    bool
+		ExpectProblems []string
+	}{
+		{
+			Name:    "valid config",
+			Content: "nvidia:\n  resourceCountName: nvidia.com/gpu\n",
+		},
+		{
+			Name:           "unknown field",
+			Content:        "nvidia:\n  resourceCoreNmae: nvidia.com/gpucores\n",
+			ExpectError:    true,
+			ExpectProblems: []string{"resourceCoreNmae"},
+		},
+		{
+			Name:    "unknown field without strict",
+			Content: "nvidia:\n  resourceCoreNmae: nvidia.com/gpucores\n",
+			Args:    []string{"--strict=false"},
+		},
+		{
+			Name:           "every problem reported",
+			Content:        "nvidia:\n  resourceCountName: nvidia.com/gpu/invalid\n  resourceCoreNmae: nvidia.com/gpucores\n",
+			ExpectError:    true,
+			ExpectProblems: []string{"resourceCoreNmae", "resourceCountName"},
+		},
+		{
+			Name: "mig geometry listed twice for a model",
+			Content: "nvidia:\n  knownMigGeometries:\n" +
+				"  - models: [A100]\n    allowedGeometries:\n    - - {name: 1g.10gb, memory: 10240, count: 7}\n" +
+				"  - models: [A30, A100]\n    allowedGeometries:\n    - - {name: 1g.10gb, memory: 10240, count: 7}\n",
+			ExpectError:    true,
+			ExpectProblems: []string{"knownMigGeometries[1].allowedGeometries[0]"},
+		},
+		{
+			Name:           "syntax error",
+			Content:        "nvidia: [\n",
+			ExpectError:    true,
+			ExpectProblems: []string{"yaml"},
+		},
+	}
+
+	for i := range tests {
+		tc := tests[i]
+
+		t.Run(tc.Name, func(t *testing.T) {
+			path := filepath.Join(t.TempDir(), "device-config.yaml")
+			if err := os.WriteFile(path, []byte(tc.Content), 0o644); err != nil {
+				t.Fatal(err)
+			}
+			out := &bytes.Buffer{}
+			cmd := NewValidateConfigCommand()
+			cmd.SetOut(out)
+			cmd.SetErr(out)
+			cmd.SetArgs(append([]string{"--device-config-file=" + path}, tc.Args...))
+
+			err := cmd.Execute()
+			if tc.ExpectError && err == nil {
+				t.Fatalf("Expect error, but got nil")
+			}
+			if !tc.ExpectError && err != nil {
+				t.Fatalf("No error is expected but got: %v", err)
+			}
+			if !tc.ExpectError && !strings.Contains(out.String(), "is valid") {
+				t.Fatalf("expect the config to be reported valid, but got: %s", out.String())
+			}
+			for _, problem := range tc.ExpectProblems {
+				if !strings.Contains(out.String(), problem) {
+					t.Fatalf("expect a problem about %s, but got: %s", problem, out.String())
+				}
+			}
+		})
+	}
+}
+
+func TestValidateConfigCommandMissingFile(t *testing.T) {
+	cmd := NewValidateConfigCommand()
+	cmd.SetOut(&bytes.Buffer{})
+	cmd.SetErr(&bytes.Buffer{})
+	cmd.SetArgs([]string{"--device-config-file=" + filepath.Join(t.TempDir(), "missing.yaml")})
+	if err := cmd.Execute(); err == nil {
+		t.Fatalf("Expect error, but got nil")
+	}
+}
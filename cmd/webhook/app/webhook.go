@@ -24,21 +24,32 @@ import (
 	"os"
 
 	"github.com/spf13/cobra"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/client-go/tools/record"
 	"k8s.io/client-go/util/flowcontrol"
 	cliflag "k8s.io/component-base/cli/flag"
 	"k8s.io/component-base/logs"
 	logsv1 "k8s.io/component-base/logs/api/v1"
 	"k8s.io/klog/v2"
 	controllerruntime "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/cache"
+	"sigs.k8s.io/controller-runtime/pkg/client"
 	metricsserver "sigs.k8s.io/controller-runtime/pkg/metrics/server"
 	"sigs.k8s.io/controller-runtime/pkg/webhook"
 	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
 
 	"github.com/Project-HAMi/HAMi-DRA/cmd/webhook/app/options"
+	policyv1alpha1 "github.com/Project-HAMi/HAMi-DRA/pkg/apis/policy/v1alpha1"
 	"github.com/Project-HAMi/HAMi-DRA/pkg/config"
+	"github.com/Project-HAMi/HAMi-DRA/pkg/constants"
+	"github.com/Project-HAMi/HAMi-DRA/pkg/controllers/cleanup"
+	controllerscontext "github.com/Project-HAMi/HAMi-DRA/pkg/controllers/context"
+	"github.com/Project-HAMi/HAMi-DRA/pkg/metrics"
 	"github.com/Project-HAMi/HAMi-DRA/pkg/version"
+	"github.com/Project-HAMi/HAMi-DRA/pkg/version/sharedcommand"
 	"github.com/Project-HAMi/HAMi-DRA/pkg/webhook/dra"
 )
 
@@ -54,7 +65,7 @@ func NewWebhookCommand(ctx context.Context) *cobra.Command {
 	genericFlagSet := fss.FlagSet("generic")
 	opts := options.NewOptions()
 	genericFlagSet.AddGoFlagSet(flag.CommandLine)
-	opts.AddFlags(genericFlagSet)
+	opts.AddFlags(genericFlagSet, controllers.ControllerNames())
 
 	cmd := &cobra.Command{
 		Use:   "webhook",
@@ -76,6 +87,9 @@ Kubernetes resources.`,
 			if err := opts.Validate(); err != nil {
 				return err
 			}
+			if err := controllers.Validate(opts.Controllers); err != nil {
+				return err
+			}
 			if err := Run(ctx, opts); err != nil {
 				return err
 			}
@@ -94,12 +108,29 @@ Kubernetes resources.`,
 	cmd.Flags().AddFlagSet(genericFlagSet)
 	cmd.Flags().AddFlagSet(logsFlagSet)
 
+	cmd.AddCommand(sharedcommand.NewCmdVersion("webhook"))
+	cmd.AddCommand(NewValidateConfigCommand())
+	cmd.AddCommand(NewPrintDefaultsCommand())
+	cmd.AddCommand(NewSchemaCommand())
+
 	cmd.SetUsageFunc(func(cmd *cobra.Command) error {
 		return nil
 	})
 	cmd.SetHelpFunc(func(cmd *cobra.Command, args []string) {
 		fmt.Fprintf(cmd.OutOrStdout(), "%s\n\n", cmd.Long)
 		fmt.Fprintf(cmd.OutOrStdout(), "Usage:\n  %s\n\n", cmd.UseLine())
+		if cmd.Example != "" {
+			fmt.Fprintf(cmd.OutOrStdout(), "Examples:\n%s\n\n", cmd.Example)
+		}
+		if cmd.HasAvailableSubCommands() {
+			fmt.Fprintf(cmd.OutOrStdout(), "Available Commands:\n")
+			for _, sub := range cmd.Commands() {
+				if sub.IsAvailableCommand() {
+					fmt.Fprintf(cmd.OutOrStdout(), "  %-16s %s\n", sub.Name(), sub.Short)
+				}
+			}
+			fmt.Fprintln(cmd.OutOrStdout())
+		}
 		fmt.Fprintf(cmd.OutOrStdout(), "Flags:\n%s\n", cmd.Flags().FlagUsages())
 	})
 	return cmd
@@ -110,27 +141,29 @@ func Run(ctx context.Context, opts *options.Options) error {
 	klog.Infof("hami-dra-webhook version: %s", version.Get())
 	klog.InfoS("Golang settings", "GOGC", os.Getenv("GOGC"), "GOMAXPROCS", os.Getenv("GOMAXPROCS"), "GOTRACEBACK", os.Getenv("GOTRACEBACK"))
 
-	deviceConfigBytes, err := os.ReadFile(opts.DeviceConfigFile)
+	deviceConfigData, err := os.ReadFile(opts.DeviceConfigFile)
 	if err != nil {
-		klog.Errorf("Failed to read device config file: %v", err)
+		klog.Errorf("Failed to read device config file %s: %v", opts.DeviceConfigFile, err)
 		return err
 	}
-	deviceConfig, err := config.Unmarshal(deviceConfigBytes)
+	deviceConfig, err := config.Parse(deviceConfigData, opts.StrictDeviceConfig)
 	if err != nil {
-		klog.Errorf("Failed to unmarshal device config: %v", err)
+		klog.Errorf("Failed to load device config: %v", err)
 		return err
 	}
+	configStore := config.NewStore(deviceConfig)
 	// Create a new scheme and add default Kubernetes schemes
 	sch := runtime.NewScheme()
 	_ = scheme.AddToScheme(sch)
+	_ = policyv1alpha1.AddToScheme(sch)
 
-	config, err := controllerruntime.GetConfig()
+	restConfig, err := controllerruntime.GetConfig()
 	if err != nil {
 		panic(err)
 	}
-	config.RateLimiter = flowcontrol.NewTokenBucketRateLimiter(opts.KubeAPIQPS, opts.KubeAPIBurst)
+	restConfig.RateLimiter = flowcontrol.NewTokenBucketRateLimiter(opts.KubeAPIQPS, opts.KubeAPIBurst)
 
-	hookManager, err := controllerruntime.NewManager(config, controllerruntime.Options{
+	hookManager, err := controllerruntime.NewManager(restConfig, controllerruntime.Options{
 		Logger: klog.Background(),
 		Scheme: sch,
 		WebhookServer: webhook.NewServer(webhook.Options{
@@ -157,9 +190,22 @@ func Run(ctx context.Context, opts *options.Options) error {
 				},
 			},
 		}),
-		LeaderElection:         false,
-		Metrics:                metricsserver.Options{BindAddress: opts.MetricsBindAddress},
-		HealthProbeBindAddress: opts.HealthProbeBindAddress,
+		Cache: cache.Options{
+			ByObject: map[client.Object]cache.ByObject{
+				// Controllers only care about translated pods, avoid caching every pod in the cluster.
+				&corev1.Pod{}: {Label: labels.SelectorFromSet(labels.Set{constants.DraLabel: "true"})},
+			},
+		},
+		LeaderElection:                opts.LeaderElection.LeaderElect,
+		LeaderElectionID:              opts.LeaderElection.ResourceName,
+		LeaderElectionNamespace:       opts.LeaderElection.ResourceNamespace,
+		LeaderElectionResourceLock:    opts.LeaderElection.ResourceLock,
+		LeaseDuration:                 &opts.LeaderElection.LeaseDuration.Duration,
+		RenewDeadline:                 &opts.LeaderElection.RenewDeadline.Duration,
+		RetryPeriod:                   &opts.LeaderElection.RetryPeriod.Duration,
+		LeaderElectionReleaseOnCancel: true,
+		Metrics:                       metricsserver.Options{BindAddress: opts.MetricsBindAddress},
+		HealthProbeBindAddress:        opts.HealthProbeBindAddress,
 	})
 	if err != nil {
 		klog.Errorf("Failed to build webhook server: %v", err)
@@ -174,14 +220,37 @@ func Run(ctx context.Context, opts *options.Options) error {
 	mutatingAdmission := &dra.MutatingAdmission{}
 	mutatingAdmission.Decoder = decoder
 	mutatingAdmission.Client = hookManager.GetClient()
-	mutatingAdmission.DeviceConfig = deviceConfig
+	mutatingAdmission.APIReader = hookManager.GetAPIReader()
+	mutatingAdmission.ConfigStore = configStore
 	hookServer.Register("/mutate", &webhook.Admission{Handler: mutatingAdmission})
 
 	validatingAdmission := &dra.ValidatingAdmission{}
 	validatingAdmission.Decoder = decoder
 	validatingAdmission.Client = hookManager.GetClient()
+	validatingAdmission.ConfigStore = configStore
 	hookServer.Register("/validate", &webhook.Admission{Handler: validatingAdmission})
 
+	if err := hookManager.Add(&config.Watcher{
+		Path:     opts.DeviceConfigFile,
+		Store:    configStore,
+		Strict:   opts.StrictDeviceConfig,
+		OnReload: newReloadRecorder(hookManager.GetEventRecorderFor("hami-dra-webhook")),
+		Loaded:   deviceConfigData,
+	}); err != nil {
+		klog.Errorf("Failed to add device config watcher: %v", err)
+		return err
+	}
+
+	controllerContext := controllerscontext.Context{
+		Mgr:         hookManager,
+		Context:     ctx,
+		ConfigStore: configStore,
+	}
+	if err := controllers.StartControllers(controllerContext, opts.Controllers); err != nil {
+		klog.Errorf("Failed to start controllers: %v", err)
+		return err
+	}
+
 	// blocks until the context is done.
 	if err := hookManager.Start(ctx); err != nil {
 		klog.Errorf("webhook server exits unexpectedly: %v", err)
@@ -191,3 +260,51 @@ func Run(ctx context.Context, opts *options.Options) error {
 	// never reach here
 	return nil
 }
+
+// newReloadRecorder returns a callback recording device config reloads as metrics and as events
+// on the webhook pod, identified by the POD_NAME and POD_NAMESPACE environment variables.
+func newReloadRecorder(recorder record.EventRecorder) func(err error) {
+	podName, podNamespace := os.Getenv("POD_NAME"), os.Getenv("POD_NAMESPACE")
+	return func(err error) {
+		metrics.RecordDeviceConfigReload(err)
+		if podName == "" || podNamespace == "" {
+			return
+		}
+		pod := &corev1.ObjectReference{Kind: "Pod", APIVersion: "v1", Name: podName, Namespace: podNamespace}
+		if err != nil {
+			recorder.Eventf(pod, corev1.EventTypeWarning, "DeviceConfigRejected", "Rejected device config update, keeping the last good config: %v", err)
+			return
+		}
+		recorder.Event(pod, corev1.EventTypeNormal, "DeviceConfigReloaded", "Reloaded device config")
+	}
+}
+
+var controllers = make(controllerscontext.Initializers)
+
+func init() {
+	controllers[cleanup.ControllerName] = startResourceClaimCleanupController
+	controllers[cleanup.TemplateControllerName] = startResourceClaimTemplateCleanupController
+}
+
+func startResourceClaimCleanupController(ctx controllerscontext.Context) (enabled bool, err error) {
+	c := &cleanup.ResourceClaimCleanupController{
+		Client:      ctx.Mgr.GetClient(),
+		GracePeriod: cleanup.DefaultGracePeriod,
+	}
+	if err := c.SetupWithManager(ctx.Mgr); err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+func startResourceClaimTemplateCleanupController(ctx controllerscontext.Context) (enabled bool, err error) {
+	c := &cleanup.ResourceClaimTemplateCleanupController{
+		Client:      ctx.Mgr.GetClient(),
+		APIReader:   ctx.Mgr.GetAPIReader(),
+		GracePeriod: cleanup.DefaultGracePeriod,
+	}
+	if err := c.SetupWithManager(ctx.Mgr); err != nil {
+		return false, err
+	}
+	return true, nil
+}
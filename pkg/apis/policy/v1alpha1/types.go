@@ -0,0 +1,86 @@
+/*
+Copyright 2025 The HAMi Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// +genclient
+// +kubebuilder:object:root=true
+// +kubebuilder:resource:scope=Namespaced,shortName=gpup
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// GPUPolicy sets the GPU rules of the pods in its namespace.
+// The webhook applies it when translating GPU resources into ResourceClaims.
+// If a namespace has several GPUPolicies, the first one by name is used.
+type GPUPolicy struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	// Spec is the GPU policy of the namespace.
+	// +required
+	Spec GPUPolicySpec `json:"spec"`
+}
+
+// GPUPolicySpec is the specification of a GPUPolicy.
+type GPUPolicySpec struct {
+	// DefaultMemory is the GPU memory in MiB requested by containers that do not request any.
+	// It overrides the defaultMemory of the device config.
+	// +kubebuilder:validation:Minimum=0
+	// +optional
+	DefaultMemory *int32 `json:"defaultMemory,omitempty"`
+
+	// DefaultCores is the percentage of GPU cores requested by containers that do not request any.
+	// It overrides the defaultCores of the device config.
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:validation:Maximum=100
+	// +optional
+	DefaultCores *int32 `json:"defaultCores,omitempty"`
+
+	// DefaultGPUType is the GPU product name used for pods without the nvidia.com/use-gputype annotation.
+	// +optional
+	DefaultGPUType string `json:"defaultGPUType,omitempty"`
+
+	// AllowedProductNames restricts the GPU product names pods may use. Empty means any.
+	// +optional
+	AllowedProductNames []string `json:"allowedProductNames,omitempty"`
+
+	// AllowedUUIDs restricts the GPUs pods may use. Empty means any.
+	// +optional
+	AllowedUUIDs []string `json:"allowedUUIDs,omitempty"`
+
+	// MaxGPUsPerContainer is the largest number of GPUs a container may request.
+	// +kubebuilder:validation:Minimum=0
+	// +optional
+	MaxGPUsPerContainer *int32 `json:"maxGPUsPerContainer,omitempty"`
+
+	// VGPUMode is the nvidia.com/vgpu-mode of the pods. Pods asking for another mode are rejected.
+	// +kubebuilder:validation:Enum=hami-core;mig;mps
+	// +optional
+	VGPUMode string `json:"vgpuMode,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// GPUPolicyList contains a list of GPUPolicy.
+type GPUPolicyList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []GPUPolicy `json:"items"`
+}
@@ -0,0 +1,61 @@
+/*
+Copyright 2025 The HAMi Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package config
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+)
+
+// AdmissionConfig selects the pods translated by the webhook.
+type AdmissionConfig struct {
+	// NamespaceSelector opts namespaces in, only the pods of matching namespaces are translated.
+	// All namespaces are translated if it is not set.
+	NamespaceSelector *LabelSelector `yaml:"namespaceSelector,omitempty"`
+	// ExcludeNamespaceSelector opts namespaces out, the pods of matching namespaces are never translated.
+	ExcludeNamespaceSelector *LabelSelector `yaml:"excludeNamespaceSelector,omitempty"`
+}
+
+// LabelSelector is the yaml form of a metav1.LabelSelector.
+type LabelSelector struct {
+	MatchLabels      map[string]string          `yaml:"matchLabels,omitempty"`
+	MatchExpressions []LabelSelectorRequirement `yaml:"matchExpressions,omitempty"`
+}
+
+// LabelSelectorRequirement is the yaml form of a metav1.LabelSelectorRequirement.
+type LabelSelectorRequirement struct {
+	Key      string   `yaml:"key"`
+	Operator string   `yaml:"operator"`
+	Values   []string `yaml:"values,omitempty"`
+}
+
+// Selector converts the label selector into a labels.Selector.
+// A nil label selector matches nothing.
+func (s *LabelSelector) Selector() (labels.Selector, error) {
+	if s == nil {
+		return labels.Nothing(), nil
+	}
+	selector := &metav1.LabelSelector{MatchLabels: s.MatchLabels}
+	for _, requirement := range s.MatchExpressions {
+		selector.MatchExpressions = append(selector.MatchExpressions, metav1.LabelSelectorRequirement{
+			Key:      requirement.Key,
+			Operator: metav1.LabelSelectorOperator(requirement.Operator),
+			Values:   requirement.Values,
+		})
+	}
+	return metav1.LabelSelectorAsSelector(selector)
+}
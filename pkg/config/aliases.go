@@ -0,0 +1,57 @@
+/*
+Copyright 2025 The HAMi Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package config
+
+// VolcanoAliasPreset is the preset translating the vGPU resources of Volcano.
+const VolcanoAliasPreset = "volcano"
+
+// ResourceAlias names GPU resources translated like the NVIDIA count, memory and core resources.
+type ResourceAlias struct {
+	// Name identifies the alias in errors and logs.
+	Name string `yaml:"name"`
+	// ResourceCountName is an alias of the resource requesting a number of GPUs.
+	ResourceCountName string `yaml:"resourceCountName"`
+	// ResourceMemoryName is an alias of the resource requesting GPU memory.
+	ResourceMemoryName string `yaml:"resourceMemoryName,omitempty"`
+	// ResourceCoreName is an alias of the resource requesting a percentage of GPU cores.
+	ResourceCoreName string `yaml:"resourceCoreName,omitempty"`
+	// MemoryUnit is the quantity of one unit of the memory resource, defaults to 1Mi like HAMi.
+	MemoryUnit string `yaml:"memoryUnit,omitempty"`
+}
+
+// aliasPresets are the built-in resource aliases, by preset name.
+var aliasPresets = map[string]ResourceAlias{
+	// Volcano requests vGPU memory in MiB and cores in percent of a GPU, like HAMi.
+	VolcanoAliasPreset: {
+		Name:               VolcanoAliasPreset,
+		ResourceCountName:  "volcano.sh/vgpu-number",
+		ResourceMemoryName: "volcano.sh/vgpu-memory",
+		ResourceCoreName:   "volcano.sh/vgpu-cores",
+		MemoryUnit:         "1Mi",
+	},
+}
+
+// Aliases returns the resource aliases of the enabled presets followed by the configured ones.
+func (c *NvidiaConfig) Aliases() []ResourceAlias {
+	var aliases []ResourceAlias
+	for _, preset := range c.ResourceAliasPresets {
+		if alias, ok := aliasPresets[preset]; ok {
+			aliases = append(aliases, alias)
+		}
+	}
+	return append(aliases, c.ResourceAliases...)
+}
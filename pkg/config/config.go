@@ -17,6 +17,10 @@ limitations under the License.
 package config
 
 import (
+	"bytes"
+	"errors"
+	"io"
+
 	"gopkg.in/yaml.v3"
 )
 
@@ -89,8 +93,33 @@ const (
 	Debugs   LibCudaLogLevel = "4"
 )
 
+// SharedGPUCapacityPolicy decides how the capacity of a shared GPU group is derived from its containers.
+type SharedGPUCapacityPolicy string
+
+const (
+	// MaxCapacityPolicy requests the largest capacity asked by any container of the group.
+	MaxCapacityPolicy SharedGPUCapacityPolicy = "max"
+	// SumCapacityPolicy requests the sum of the capacities asked by the containers of the group.
+	SumCapacityPolicy SharedGPUCapacityPolicy = "sum"
+)
+
+// Config is the device config shared with the HAMi scheduler, one section per vendor.
 type Config struct {
-	Nvidia NvidiaConfig `yaml:"nvidia"`
+	Nvidia    NvidiaConfig     `yaml:"nvidia"`
+	Cambricon CambriconConfig  `yaml:"cambricon"`
+	Hygon     HygonConfig      `yaml:"hygon"`
+	Metax     MetaxConfig      `yaml:"metax"`
+	Enflame   EnflameConfig    `yaml:"enflame"`
+	Mthreads  MthreadsConfig   `yaml:"mthreads"`
+	Iluvatars []IluvatarConfig `yaml:"iluvatars"`
+	Kunlun    KunlunConfig     `yaml:"kunlun"`
+	AWSNeuron AWSNeuronConfig  `yaml:"awsneuron"`
+	AMD       AMDConfig        `yaml:"amd"`
+	VNPUs     []VNPUConfig     `yaml:"vnpus"`
+	// Admission selects the pods translated by the webhook, it is not part of the HAMi scheduler config.
+	Admission AdmissionConfig `yaml:"admission,omitempty"`
+	// Rules customize the ResourceClaims generated for matching pods, they are not part of the HAMi scheduler config.
+	Rules []MutationRule `yaml:"rules,omitempty"`
 }
 
 type NvidiaConfig struct {
@@ -112,19 +141,27 @@ type NvidiaConfig struct {
 	GPUCorePolicy GPUCoreUtilizationPolicy `yaml:"gpuCorePolicy"`
 	// RuntimeClassName is the name of the runtime class to be added to pod.spec.runtimeClassName
 	RuntimeClassName string `yaml:"runtimeClassName"`
+	// SharedGPUCapacityPolicy decides the capacity of a GPU claim shared by several containers, defaults to max.
+	SharedGPUCapacityPolicy SharedGPUCapacityPolicy `yaml:"sharedGPUCapacityPolicy"`
+	// ResourceAliasPresets enables built-in resource aliases, such as volcano.
+	ResourceAliasPresets []string `yaml:"resourceAliasPresets,omitempty"`
+	// ResourceAliases are other names of the GPU resources, translated into the same ResourceClaims.
+	ResourceAliases []ResourceAlias `yaml:"resourceAliases,omitempty"`
+	// DRA describes the DRA driver the generated ResourceClaims are written for, defaults to the HAMi driver.
+	DRA DRAConfig `yaml:"dra"`
 }
 
 // These configs can be sepecified for each node by using Nodeconfig.
 type NodeDefaultConfig struct {
 	//nolint:tagalign
-	DeviceSplitCount *uint `yaml:"deviceSplitCount" json:"devicesplitcount"`
+	DeviceSplitCount *uint `yaml:"deviceSplitCount,omitempty" json:"devicesplitcount"`
 	//nolint:tagalign
-	DeviceMemoryScaling *float64 `yaml:"deviceMemoryScaling" json:"devicememoryscaling"`
+	DeviceMemoryScaling *float64 `yaml:"deviceMemoryScaling,omitempty" json:"devicememoryscaling"`
 	//nolint:tagalign
-	DeviceCoreScaling *float64 `yaml:"deviceCoreScaling" json:"devicecorescaling"`
+	DeviceCoreScaling *float64 `yaml:"deviceCoreScaling,omitempty" json:"devicecorescaling"`
 	// LogLevel is LIBCUDA_LOG_LEVEL value
 	//nolint:tagalign
-	LogLevel *LibCudaLogLevel `yaml:"libCudaLogLevel" json:"libcudaloglevel"`
+	LogLevel *LibCudaLogLevel `yaml:"libCudaLogLevel,omitempty" json:"libcudaloglevel"`
 }
 
 type FilterDevice struct {
@@ -153,19 +190,38 @@ type AllowedMigGeometries struct {
 type Geometry []MigTemplate
 
 type MigTemplate struct {
-	Name   string `yaml:"name"`
-	Memory int32  `yaml:"memory"`
-	Count  int32  `yaml:"count"`
+	Name string `yaml:"name"`
+	// Core is the share of the GPU's compute, in percent, given to an instance of this template.
+	Core   int32 `yaml:"core"`
+	Memory int32 `yaml:"memory"`
+	Count  int32 `yaml:"count"`
 }
 
 func Unmarshal(data []byte) (*NvidiaConfig, error) {
-	var config Config
-	if err := yaml.Unmarshal(data, &config); err != nil {
+	config, err := UnmarshalConfig(data, false)
+	if err != nil {
 		return nil, err
 	}
 	return &config.Nvidia, nil
 }
 
+// UnmarshalConfig parses every vendor section of the device config.
+// In strict mode, unknown fields such as typos are reported as errors instead of being dropped.
+func UnmarshalConfig(data []byte, strict bool) (*Config, error) {
+	var config Config
+	decoder := yaml.NewDecoder(bytes.NewReader(data))
+	decoder.KnownFields(strict)
+	if err := decoder.Decode(&config); err != nil && !errors.Is(err, io.EOF) {
+		return nil, err
+	}
+	return &config, nil
+}
+
 func Marshal(nvidiaConfig *NvidiaConfig) ([]byte, error) {
 	return yaml.Marshal(nvidiaConfig)
 }
+
+// MarshalConfig serializes every vendor section of the device config.
+func MarshalConfig(config *Config) ([]byte, error) {
+	return yaml.Marshal(config)
+}
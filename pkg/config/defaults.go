@@ -0,0 +1,144 @@
+/*
+Copyright 2025 The HAMi Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package config
+
+import (
+	"github.com/Project-HAMi/HAMi-DRA/pkg/constants"
+)
+
+const (
+	DefaultResourceCountName            = "nvidia.com/gpu"
+	DefaultResourceMemoryName           = "nvidia.com/gpumem"
+	DefaultResourceCoreName             = "nvidia.com/gpucores"
+	DefaultResourceMemoryPercentageName = "nvidia.com/gpumem-percentage"
+	DefaultResourcePriorityName         = "nvidia.com/priority"
+	DefaultGPUNum                       = 1
+	DefaultMemoryUnit                   = "1Mi"
+	DefaultCambriconMemoryUnit          = "256Mi"
+	DefaultMthreadsMemoryUnit           = "512Mi"
+	DefaultMetaxMemoryUnit              = "1Gi"
+	DefaultMetaxTopologyAttribute       = "interconnectDomain"
+	DefaultEnflamePercentageCapacity    = "percentage"
+	DefaultIluvatarMemoryUnit           = "256Mi"
+	DefaultAWSNeuronCoreDeviceAttribute = "deviceIndex"
+	DefaultAWSNeuronCoresPerDevice      = 8
+
+	DefaultNvidiaDRADriverName         = "gpu.nvidia.com"
+	DefaultNvidiaDRAMigDeviceClassName = "mig.nvidia.com"
+	DefaultDRARequestName              = "gpu"
+	DefaultDRATypeAttribute            = "type"
+	DefaultDRAUUIDAttribute            = "uuid"
+	DefaultDRAProductNameAttribute     = "productName"
+)
+
+// DefaultKunlunVXPUMemorySizes are the partition sizes in MiB of a Kunlun P800 XPU.
+var DefaultKunlunVXPUMemorySizes = []int64{24576, 49152, 98304}
+
+// SetDefaults fills the unset fields of the device config with the values the webhook uses for them.
+func SetDefaults(config *Config) {
+	setNvidiaDefaults(&config.Nvidia)
+	setDefault(&config.Cambricon.MemoryUnit, DefaultCambriconMemoryUnit)
+	setDefault(&config.Hygon.MemoryUnit, DefaultMemoryUnit)
+	setDefault(&config.Mthreads.MemoryUnit, DefaultMthreadsMemoryUnit)
+	setDefault(&config.Metax.MemoryUnit, DefaultMetaxMemoryUnit)
+	setDefault(&config.Metax.TopologyAttribute, DefaultMetaxTopologyAttribute)
+	setDefault(&config.Enflame.PercentageCapacity, DefaultEnflamePercentageCapacity)
+	setVendorDRADefaults(&config.Cambricon.DRA)
+	setVendorDRADefaults(&config.Hygon.DRA)
+	setVendorDRADefaults(&config.Mthreads.DRA)
+	setVendorDRADefaults(&config.Metax.DRA)
+	setVendorDRADefaults(&config.Enflame.DRA)
+	if len(config.Kunlun.VXPUMemorySizes) == 0 {
+		config.Kunlun.VXPUMemorySizes = append([]int64(nil), DefaultKunlunVXPUMemorySizes...)
+	}
+	setVendorDRADefaults(&config.Kunlun.DRA)
+	setAWSNeuronDefaults(&config.AWSNeuron)
+	setVendorDRADefaults(&config.AMD.DRA)
+	for i := range config.Iluvatars {
+		setDefault(&config.Iluvatars[i].MemoryUnit, DefaultIluvatarMemoryUnit)
+		setVendorDRADefaults(&config.Iluvatars[i].DRA)
+	}
+	for i := range config.VNPUs {
+		setVendorDRADefaults(&config.VNPUs[i].DRA)
+	}
+	for i := range config.Rules {
+		setDefault(&config.Rules[i].FailurePolicy, FailRulePolicy)
+	}
+}
+
+func setNvidiaDefaults(nvidiaConfig *NvidiaConfig) {
+	setDefault(&nvidiaConfig.ResourceCountName, DefaultResourceCountName)
+	setDefault(&nvidiaConfig.ResourceMemoryName, DefaultResourceMemoryName)
+	setDefault(&nvidiaConfig.ResourceCoreName, DefaultResourceCoreName)
+	setDefault(&nvidiaConfig.ResourceMemoryPercentageName, DefaultResourceMemoryPercentageName)
+	setDefault(&nvidiaConfig.ResourcePriority, DefaultResourcePriorityName)
+	setDefault(&nvidiaConfig.GPUCorePolicy, DefaultCorePolicy)
+	setDefault(&nvidiaConfig.SharedGPUCapacityPolicy, MaxCapacityPolicy)
+	if nvidiaConfig.DefaultGPUNum == 0 {
+		nvidiaConfig.DefaultGPUNum = DefaultGPUNum
+	}
+	setDRADefaults(&nvidiaConfig.DRA)
+	for i := range nvidiaConfig.ResourceAliases {
+		setDefault(&nvidiaConfig.ResourceAliases[i].MemoryUnit, DefaultMemoryUnit)
+	}
+}
+
+func setDRADefaults(draConfig *DRAConfig) {
+	setDefault(&draConfig.Profile, HAMiDRAProfile)
+	switch draConfig.Profile {
+	case NvidiaDRAProfile:
+		// The DeviceClasses of the upstream driver already select the device type.
+		setDefault(&draConfig.DriverName, DefaultNvidiaDRADriverName)
+		setDefault(&draConfig.MigDeviceClassName, DefaultNvidiaDRAMigDeviceClassName)
+	default:
+		if draConfig.DriverName == "" {
+			draConfig.DriverName = constants.NvidiaDraDriver
+			setDefault(&draConfig.DeviceType, constants.NvidiaDeviceType)
+		}
+	}
+	setDefault(&draConfig.DeviceClassName, draConfig.DriverName)
+	setDefault(&draConfig.RequestName, DefaultDRARequestName)
+	setDefault(&draConfig.Attributes.Type, DefaultDRATypeAttribute)
+	setDefault(&draConfig.Attributes.UUID, DefaultDRAUUIDAttribute)
+	setDefault(&draConfig.Attributes.ProductName, DefaultDRAProductNameAttribute)
+}
+
+func setAWSNeuronDefaults(neuronConfig *AWSNeuronConfig) {
+	setVendorDRADefaults(&neuronConfig.DRA)
+	if neuronConfig.DRA.Enabled() {
+		setDefault(&neuronConfig.CoreDeviceClassName, "neuroncore."+neuronConfig.DRA.DriverName)
+	}
+	setDefault(&neuronConfig.CoreDeviceAttribute, DefaultAWSNeuronCoreDeviceAttribute)
+	if neuronConfig.CoresPerDevice == 0 {
+		neuronConfig.CoresPerDevice = DefaultAWSNeuronCoresPerDevice
+	}
+}
+
+func setVendorDRADefaults(draConfig *VendorDRAConfig) {
+	if !draConfig.Enabled() {
+		return
+	}
+	setDefault(&draConfig.DeviceClassName, draConfig.DriverName)
+	setDefault(&draConfig.ProductNameAttribute, DefaultDRAProductNameAttribute)
+}
+
+func setDefault[T comparable](field *T, value T) {
+	var zero T
+	if *field == zero {
+		*field = value
+	}
+}
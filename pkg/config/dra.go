@@ -0,0 +1,68 @@
+/*
+Copyright 2025 The HAMi Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package config
+
+// DRAProfile is the translation model of the DRA driver.
+type DRAProfile string
+
+const (
+	// HAMiDRAProfile translates GPU memory and cores into consumable capacity of the HAMi driver.
+	HAMiDRAProfile DRAProfile = "hami-core"
+	// NvidiaDRAProfile translates into whole GPU or MIG requests of the upstream NVIDIA k8s-dra-driver-gpu,
+	// which cannot limit GPU memory or cores.
+	NvidiaDRAProfile DRAProfile = "nvidia"
+)
+
+// DRAConfig describes the DRA driver the generated ResourceClaims are written for.
+type DRAConfig struct {
+	// Profile is the translation model of the driver, defaults to hami-core.
+	// It also decides the defaults of the other fields.
+	Profile DRAProfile `yaml:"profile"`
+	// DriverName is the name of the DRA driver, it qualifies the device attributes in selectors.
+	DriverName string `yaml:"driverName"`
+	// DeviceClassName is the DeviceClass requested for the count resource, defaults to the driver name.
+	DeviceClassName string `yaml:"deviceClassName"`
+	// MigDeviceClassName is the DeviceClass requested by pods in MIG mode with the nvidia profile.
+	MigDeviceClassName string `yaml:"migDeviceClassName,omitempty"`
+	// DeviceType is the value of the device type attribute the claims select, no type selector is added if empty.
+	DeviceType string `yaml:"deviceType"`
+	// RequestName is the name of the device request in the generated claims.
+	RequestName string `yaml:"requestName"`
+	// Attributes are the names of the device attributes published by the driver.
+	Attributes DRAAttributes `yaml:"attributes"`
+	// DeviceClasses map additional count resource names to their own DeviceClass,
+	// for example a resource only requesting A100 GPUs.
+	DeviceClasses []ResourceDeviceClass `yaml:"deviceClasses,omitempty"`
+}
+
+// DRAAttributes are the names of the device attributes used in the generated selectors.
+type DRAAttributes struct {
+	// Type is the attribute holding the device type.
+	Type string `yaml:"type"`
+	// UUID is the attribute holding the device UUID, matched against the nvidia.com/use-gpuuuid annotation.
+	UUID string `yaml:"uuid"`
+	// ProductName is the attribute holding the product name, matched against the nvidia.com/use-gputype annotation.
+	ProductName string `yaml:"productName"`
+}
+
+// ResourceDeviceClass maps a count resource name to a DeviceClass.
+type ResourceDeviceClass struct {
+	// ResourceName is the extended resource name requesting a number of devices of the class.
+	ResourceName string `yaml:"resourceName"`
+	// DeviceClassName is the DeviceClass requested for the resource.
+	DeviceClassName string `yaml:"deviceClassName"`
+}
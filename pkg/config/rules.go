@@ -0,0 +1,231 @@
+/*
+Copyright 2025 The HAMi Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package config
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/google/cel-go/cel"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/validation"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+)
+
+// ruleCostLimit bounds the evaluation cost of a rule expression, so that a rule cannot stall admission.
+const ruleCostLimit = 1000000
+
+// TranslatorNames are the names of the device translators registered in pkg/device, the ones rules can be limited to.
+var TranslatorNames = []string{"amd", "ascend", "awsneuron", "cambricon", "enflame", "hygon", "iluvatar", "kunlun", "metax", "mthreads", "nvidia"}
+
+// RuleFailurePolicy decides what happens to a pod a rule fails to be evaluated against.
+type RuleFailurePolicy string
+
+const (
+	// FailRulePolicy rejects the pod.
+	FailRulePolicy RuleFailurePolicy = "Fail"
+	// IgnoreRulePolicy translates the pod as if the rule did not match it.
+	IgnoreRulePolicy RuleFailurePolicy = "Ignore"
+)
+
+// MutationRule adds selectors, constraints and capacity defaults to the ResourceClaims generated for matching pods.
+type MutationRule struct {
+	// Name identifies the rule in errors and logs.
+	Name string `yaml:"name"`
+	// Match is a CEL expression over the pod, available as the `pod` variable, that returns whether the rule applies.
+	Match string `yaml:"match"`
+	// FailurePolicy decides whether a pod the Match expression fails to be evaluated against, such as one
+	// without a field it reads, is rejected or translated as if the rule did not match, defaults to Fail.
+	FailurePolicy RuleFailurePolicy `yaml:"failurePolicy,omitempty"`
+	// Translators are the device translators, such as nvidia or cambricon, whose requests the rule changes.
+	// The rule changes the requests of every translator if empty.
+	Translators []string `yaml:"translators,omitempty"`
+	// Selectors are CEL device selectors added to the generated claims.
+	Selectors []string `yaml:"selectors,omitempty"`
+	// Constraints are the attributes that all devices of a generated claim must share.
+	Constraints []RuleConstraint `yaml:"constraints,omitempty"`
+	// Capacity are the capacities requested by generated claims that do not request them already, such as memory or cores.
+	Capacity map[string]string `yaml:"capacity,omitempty"`
+
+	// program is the compiled Match expression, set by CompileRules.
+	program cel.Program
+	// capacity is the parsed Capacity, set by CompileRules.
+	capacity map[string]resource.Quantity
+}
+
+// RuleConstraint is a constraint added to the generated claims.
+type RuleConstraint struct {
+	// MatchAttribute is the fully qualified name of the device attribute all devices must share.
+	MatchAttribute string `yaml:"matchAttribute"`
+}
+
+// Matches evaluates the rule against the pod.
+func (r *MutationRule) Matches(pod *corev1.Pod) (bool, error) {
+	if r.program == nil {
+		return false, fmt.Errorf("rule %q is not compiled", r.Name)
+	}
+	object, err := runtime.DefaultUnstructuredConverter.ToUnstructured(pod)
+	if err != nil {
+		return false, err
+	}
+	out, _, err := r.program.Eval(map[string]any{"pod": object})
+	if err != nil {
+		return false, fmt.Errorf("failed to evaluate rule %q: %w", r.Name, err)
+	}
+	matched, ok := out.Value().(bool)
+	if !ok {
+		return false, fmt.Errorf("rule %q returned %v instead of a bool", r.Name, out.Type())
+	}
+	return matched, nil
+}
+
+// AppliesTo reports whether the rule changes the requests of the named device translator.
+func (r *MutationRule) AppliesTo(translator string) bool {
+	return len(r.Translators) == 0 || slices.Contains(r.Translators, translator)
+}
+
+// CapacityRequests returns the parsed capacity defaults of the rule.
+func (r *MutationRule) CapacityRequests() map[string]resource.Quantity {
+	return r.capacity
+}
+
+// CompileRules compiles the Match expressions and parses the capacities of validated rules in place,
+// so that they can be evaluated with Matches.
+func CompileRules(rules []MutationRule) error {
+	env, err := newRuleEnv()
+	if err != nil {
+		return err
+	}
+	for i := range rules {
+		rule := &rules[i]
+		program, err := compileMatch(env, rule.Match)
+		if err != nil {
+			return fmt.Errorf("failed to compile rule %q: %w", rule.Name, err)
+		}
+		capacity := make(map[string]resource.Quantity, len(rule.Capacity))
+		for name, value := range rule.Capacity {
+			qty, err := resource.ParseQuantity(value)
+			if err != nil {
+				return fmt.Errorf("failed to parse capacity %s of rule %q: %w", name, rule.Name, err)
+			}
+			capacity[name] = qty
+		}
+		rule.program, rule.capacity = program, capacity
+	}
+	return nil
+}
+
+// newRuleEnv returns the CEL environment the Match expressions are compiled in.
+func newRuleEnv() (*cel.Env, error) {
+	return cel.NewEnv(cel.Variable("pod", cel.DynType), cel.OptionalTypes())
+}
+
+// compileMatch compiles a Match expression, which must return a bool.
+func compileMatch(env *cel.Env, match string) (cel.Program, error) {
+	ast, issues := env.Compile(match)
+	if issues != nil && issues.Err() != nil {
+		return nil, issues.Err()
+	}
+	if ast.OutputType() != cel.BoolType && ast.OutputType() != cel.DynType {
+		return nil, fmt.Errorf("must return a bool, not %s", ast.OutputType())
+	}
+	return env.Program(ast, cel.CostLimit(ruleCostLimit))
+}
+
+// validateRules checks the rules, including that their expressions compile.
+func validateRules(rules []MutationRule, fldPath *field.Path) field.ErrorList {
+	var errs field.ErrorList
+
+	env, err := newRuleEnv()
+	if err != nil {
+		return append(errs, field.InternalError(fldPath, err))
+	}
+	// Device selectors are compiled by the API server against the device attributes, only their syntax is checked here.
+	deviceEnv, err := cel.NewEnv(cel.Variable("device", cel.DynType), cel.OptionalTypes())
+	if err != nil {
+		return append(errs, field.InternalError(fldPath, err))
+	}
+	names := make(map[string]bool, len(rules))
+	for i := range rules {
+		rule := &rules[i]
+		idxPath := fldPath.Index(i)
+
+		if rule.Name == "" {
+			errs = append(errs, field.Required(idxPath.Child("name"), "the rule name is required"))
+		} else if names[rule.Name] {
+			errs = append(errs, field.Duplicate(idxPath.Child("name"), rule.Name))
+		}
+		names[rule.Name] = true
+
+		switch rule.FailurePolicy {
+		case "", FailRulePolicy, IgnoreRulePolicy:
+		default:
+			errs = append(errs, field.NotSupported(idxPath.Child("failurePolicy"), rule.FailurePolicy,
+				[]RuleFailurePolicy{FailRulePolicy, IgnoreRulePolicy}))
+		}
+		for j, translator := range rule.Translators {
+			if !slices.Contains(TranslatorNames, translator) {
+				errs = append(errs, field.NotSupported(idxPath.Child("translators").Index(j), translator, TranslatorNames))
+			}
+		}
+		for j, selector := range rule.Selectors {
+			if _, issues := deviceEnv.Compile(selector); issues != nil && issues.Err() != nil {
+				errs = append(errs, field.Invalid(idxPath.Child("selectors").Index(j), selector, issues.Err().Error()))
+			}
+		}
+		for name, value := range rule.Capacity {
+			if msgs := validateQualifiedName(name); len(msgs) > 0 {
+				errs = append(errs, field.Invalid(idxPath.Child("capacity").Key(name), name, strings.Join(msgs, "; ")))
+				continue
+			}
+			if _, err := resource.ParseQuantity(value); err != nil {
+				errs = append(errs, field.Invalid(idxPath.Child("capacity").Key(name), value, err.Error()))
+			}
+		}
+		for j, constraint := range rule.Constraints {
+			attrPath := idxPath.Child("constraints").Index(j).Child("matchAttribute")
+			if constraint.MatchAttribute == "" {
+				errs = append(errs, field.Required(attrPath, "the attribute name is required"))
+			} else if !strings.Contains(constraint.MatchAttribute, "/") {
+				errs = append(errs, field.Invalid(attrPath, constraint.MatchAttribute, "must be fully qualified, such as <driver>/<attribute>"))
+			} else if msgs := validateQualifiedName(constraint.MatchAttribute); len(msgs) > 0 {
+				errs = append(errs, field.Invalid(attrPath, constraint.MatchAttribute, strings.Join(msgs, "; ")))
+			}
+		}
+
+		if rule.Match == "" {
+			errs = append(errs, field.Required(idxPath.Child("match"), "the match expression is required"))
+			continue
+		}
+		if _, err := compileMatch(env, rule.Match); err != nil {
+			errs = append(errs, field.Invalid(idxPath.Child("match"), rule.Match, err.Error()))
+		}
+	}
+	return errs
+}
+
+// validateQualifiedName checks a DRA capacity or attribute name, a C identifier optionally prefixed by a domain.
+func validateQualifiedName(name string) []string {
+	domain, id, found := strings.Cut(name, "/")
+	if !found {
+		return validation.IsCIdentifier(name)
+	}
+	return append(validation.IsDNS1123Subdomain(domain), validation.IsCIdentifier(id)...)
+}
@@ -0,0 +1,176 @@
+/*
+Copyright 2025 The HAMi Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package config
+
+import (
+	"testing"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestMutationRules(t *testing.T) {
+	tests := []struct {
+		Name        string
+		Rules       string
+		Labels      map[string]string
+		ExpectError bool
+		// ExpectEvalError is set for rules that are valid but fail to evaluate against the pod.
+		ExpectEvalError bool
+		ExpectMatch     bool
+	}{
+		{
+			Name: "matching label",
+			Rules: `
+rules:
+  - name: inference
+    match: 'pod.metadata.?labels.team.orValue("") == "inference"'
+    selectors:
+      - 'device.attributes["hami-core-gpu.project-hami.io"].productName in ["L4", "L40S"]'
+    capacity:
+      memory: 8Gi
+`,
+			Labels:      map[string]string{"team": "inference"},
+			ExpectMatch: true,
+		},
+		{
+			Name: "other label",
+			Rules: `
+rules:
+  - name: inference
+    match: 'pod.metadata.labels["team"] == "inference"'
+`,
+			Labels:      map[string]string{"team": "training"},
+			ExpectMatch: false,
+		},
+		{
+			Name: "pod without labels",
+			Rules: `
+rules:
+  - name: inference
+    match: 'pod.metadata.labels["team"] == "inference"'
+`,
+			ExpectEvalError: true,
+		},
+		{
+			Name: "invalid expression",
+			Rules: `
+rules:
+  - name: broken
+    match: 'pod.metadata.labels["team"] =='
+`,
+			ExpectError: true,
+		},
+		{
+			Name: "not a bool",
+			Rules: `
+rules:
+  - name: string
+    match: '"inference"'
+`,
+			ExpectError: true,
+		},
+		{
+			Name: "unknown translator",
+			Rules: `
+rules:
+  - name: inference
+    match: 'true'
+    translators: [nvida]
+`,
+			ExpectError: true,
+		},
+		{
+			Name: "unknown failure policy",
+			Rules: `
+rules:
+  - name: inference
+    match: 'true'
+    failurePolicy: Skip
+`,
+			ExpectError: true,
+		},
+		{
+			Name: "unqualified constraint",
+			Rules: `
+rules:
+  - name: same-type
+    match: 'true'
+    constraints:
+      - matchAttribute: productName
+`,
+			ExpectError: true,
+		},
+	}
+
+	for i := range tests {
+		tc := tests[i]
+
+		t.Run(tc.Name, func(t *testing.T) {
+			config, err := Parse([]byte("nvidia:\n  resourceCountName: nvidia.com/gpu\n"+tc.Rules), true)
+			if tc.ExpectError {
+				if err == nil {
+					t.Fatal("Expect error, but got nil")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("No error is expected but got: %v", err)
+			}
+
+			pod := &corev1.Pod{ObjectMeta: metav1.ObjectMeta{Name: "pod", Labels: tc.Labels}}
+			matched, err := config.Rules[0].Matches(pod)
+			if tc.ExpectEvalError {
+				if err == nil {
+					t.Fatal("Expect error, but got nil")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("No error is expected but got: %v", err)
+			}
+			if matched != tc.ExpectMatch {
+				t.Fatalf("expect match: %v, but got: %v", tc.ExpectMatch, matched)
+			}
+		})
+	}
+}
+
+func TestValidateRulesHasNoSideEffects(t *testing.T) {
+	config := &Config{Rules: []MutationRule{{Name: "all", Match: "true", Capacity: map[string]string{"memory": "1Gi"}}}}
+	SetDefaults(config)
+	if errs := Validate(config); len(errs) > 0 {
+		t.Fatalf("No error is expected but got: %v", errs.ToAggregate())
+	}
+	if _, err := config.Rules[0].Matches(&corev1.Pod{}); err == nil {
+		t.Fatal("Expect error for a rule that is not compiled, but got nil")
+	}
+
+	if err := CompileRules(config.Rules); err != nil {
+		t.Fatalf("No error is expected but got: %v", err)
+	}
+	matched, err := config.Rules[0].Matches(&corev1.Pod{})
+	if err != nil {
+		t.Fatalf("No error is expected but got: %v", err)
+	}
+	if !matched {
+		t.Fatal("expect the rule to match")
+	}
+	if qty := config.Rules[0].CapacityRequests()["memory"]; qty.String() != "1Gi" {
+		t.Fatalf("expect memory capacity: 1Gi, but got: %s", qty.String())
+	}
+}
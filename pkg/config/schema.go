@@ -0,0 +1,135 @@
+/*
+Copyright 2025 The HAMi Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package config
+
+//go:generate go run ../../hack/config-descriptions
+
+import (
+	"encoding/json"
+	"reflect"
+	"strings"
+)
+
+// JSONSchemaDraft is the JSON Schema dialect of the device config schema.
+const JSONSchemaDraft = "https://json-schema.org/draft/2020-12/schema"
+
+// JSONSchema is the subset of JSON Schema needed to describe the device config.
+type JSONSchema struct {
+	Schema               string                 `json:"$schema,omitempty"`
+	Title                string                 `json:"title,omitempty"`
+	Description          string                 `json:"description,omitempty"`
+	Type                 string                 `json:"type,omitempty"`
+	Properties           map[string]*JSONSchema `json:"properties,omitempty"`
+	AdditionalProperties any                    `json:"additionalProperties,omitempty"`
+	Items                *JSONSchema            `json:"items,omitempty"`
+	Enum                 []string               `json:"enum,omitempty"`
+}
+
+// enums are the allowed values of the string types of the device config.
+var enums = map[reflect.Type][]string{
+	reflect.TypeOf(GPUCoreUtilizationPolicy("")): {string(DefaultCorePolicy), string(ForceCorePolicy), string(DisableCorePolicy)},
+	reflect.TypeOf(LibCudaLogLevel("")):          {string(Error), string(Warnings), string(Infos), string(Debugs)},
+	reflect.TypeOf(SharedGPUCapacityPolicy("")):  {string(MaxCapacityPolicy), string(SumCapacityPolicy)},
+	reflect.TypeOf(DRAProfile("")):               {string(HAMiDRAProfile), string(NvidiaDRAProfile)},
+	reflect.TypeOf(RuleFailurePolicy("")):        {string(FailRulePolicy), string(IgnoreRulePolicy)},
+}
+
+// Schema returns the JSON Schema of the device config, derived from the yaml tags of its types.
+// Unknown fields are not allowed, as in strict mode.
+func Schema() *JSONSchema {
+	schema := schemaFor(reflect.TypeOf(Config{}))
+	schema.Schema = JSONSchemaDraft
+	schema.Title = "HAMi DRA device config"
+	return schema
+}
+
+// MarshalSchema serializes the JSON Schema of the device config.
+func MarshalSchema() ([]byte, error) {
+	data, err := json.MarshalIndent(Schema(), "", "  ")
+	if err != nil {
+		return nil, err
+	}
+	return append(data, '\n'), nil
+}
+
+func schemaFor(t reflect.Type) *JSONSchema {
+	t = derefType(t)
+	schema := &JSONSchema{Description: descriptions[t.Name()]}
+	if values, ok := enums[t]; ok {
+		schema.Type = "string"
+		schema.Enum = values
+		return schema
+	}
+
+	switch t.Kind() {
+	case reflect.Struct:
+		schema.Type = "object"
+		schema.Properties = make(map[string]*JSONSchema)
+		schema.AdditionalProperties = false
+		addProperties(schema, t)
+	case reflect.Slice, reflect.Array:
+		schema.Type = "array"
+		schema.Items = schemaFor(t.Elem())
+	case reflect.Map:
+		schema.Type = "object"
+		schema.AdditionalProperties = schemaFor(t.Elem())
+	case reflect.String:
+		schema.Type = "string"
+	case reflect.Bool:
+		schema.Type = "boolean"
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		schema.Type = "integer"
+	case reflect.Float32, reflect.Float64:
+		schema.Type = "number"
+	}
+	// Interfaces, such as the device filter of MIG configs, accept any value.
+	return schema
+}
+
+// addProperties adds the fields of the struct t to the properties of schema, following the yaml tags.
+func addProperties(schema *JSONSchema, t reflect.Type) {
+	for i := range t.NumField() {
+		field := t.Field(i)
+		if !field.IsExported() {
+			continue
+		}
+		name, options, _ := strings.Cut(field.Tag.Get("yaml"), ",")
+		if name == "-" {
+			continue
+		}
+		if strings.Contains(options, "inline") {
+			addProperties(schema, derefType(field.Type))
+			continue
+		}
+		if name == "" {
+			name = strings.ToLower(field.Name)
+		}
+		property := schemaFor(field.Type)
+		if description, ok := descriptions[t.Name()+"."+field.Name]; ok {
+			property.Description = description
+		}
+		schema.Properties[name] = property
+	}
+}
+
+func derefType(t reflect.Type) reflect.Type {
+	for t.Kind() == reflect.Pointer {
+		t = t.Elem()
+	}
+	return t
+}
@@ -0,0 +1,115 @@
+/*
+Copyright 2025 The HAMi Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package config
+
+import (
+	"encoding/json"
+	"slices"
+	"testing"
+)
+
+func TestSchema(t *testing.T) {
+	schema := Schema()
+	nvidia := schema.Properties["nvidia"]
+	if nvidia == nil {
+		t.Fatalf("expect a nvidia property, but got none")
+	}
+
+	tests := []struct {
+		Name        string
+		Property    string
+		Type        string
+		Enum        []string
+		Description bool
+	}{
+		{
+			Name:        "enum of the core policy",
+			Property:    "gpuCorePolicy",
+			Type:        "string",
+			Enum:        []string{"default", "force", "disable"},
+			Description: true,
+		},
+		{
+			Name:     "inlined pointer to an enum",
+			Property: "libCudaLogLevel",
+			Type:     "string",
+			Enum:     []string{"0", "1", "3", "4"},
+		},
+		{
+			Name:     "inlined pointer to a number",
+			Property: "deviceMemoryScaling",
+			Type:     "number",
+		},
+		{
+			Name:        "nested struct",
+			Property:    "dra",
+			Type:        "object",
+			Description: true,
+		},
+		{
+			Name:     "slice of structs",
+			Property: "resourceAliases",
+			Type:     "array",
+		},
+	}
+	for i := range tests {
+		tc := tests[i]
+		t.Run(tc.Name, func(t *testing.T) {
+			property := nvidia.Properties[tc.Property]
+			if property == nil {
+				t.Fatalf("expect property %s, but got none", tc.Property)
+			}
+			if property.Type != tc.Type {
+				t.Errorf("expect type %s, but got: %s", tc.Type, property.Type)
+			}
+			if !slices.Equal(property.Enum, tc.Enum) {
+				t.Errorf("expect enum %v, but got: %v", tc.Enum, property.Enum)
+			}
+			if tc.Description && property.Description == "" {
+				t.Errorf("expect a description, but got none")
+			}
+		})
+	}
+
+	if _, ok := nvidia.Properties["NodeDefaultConfig"]; ok {
+		t.Errorf("expect inlined fields to be flattened, but got a NodeDefaultConfig property")
+	}
+	if nvidia.AdditionalProperties != false {
+		t.Errorf("expect unknown fields to be rejected, but got additionalProperties: %v", nvidia.AdditionalProperties)
+	}
+	rules := schema.Properties["rules"].Items
+	if _, ok := rules.Properties["program"]; ok {
+		t.Errorf("expect unexported fields to be skipped, but got a program property")
+	}
+	if capacity := rules.Properties["capacity"]; capacity.Type != "object" || capacity.AdditionalProperties.(*JSONSchema).Type != "string" {
+		t.Errorf("expect capacity to be a map of strings, but got: %+v", capacity)
+	}
+}
+
+func TestMarshalSchema(t *testing.T) {
+	data, err := MarshalSchema()
+	if err != nil {
+		t.Fatalf("No error is expected but got: %v", err)
+	}
+	var schema map[string]any
+	if err := json.Unmarshal(data, &schema); err != nil {
+		t.Fatalf("No error is expected but got: %v", err)
+	}
+	if schema["$schema"] != JSONSchemaDraft {
+		t.Errorf("expect $schema %s, but got: %v", JSONSchemaDraft, schema["$schema"])
+	}
+}
@@ -0,0 +1,651 @@
+/*
+Copyright 2025 The HAMi Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package config
+
+import (
+	"fmt"
+	"os"
+	"strings"
+
+	apiresource "k8s.io/apimachinery/pkg/api/resource"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/apimachinery/pkg/util/validation"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+)
+
+// Validate checks the semantic rules of the device config.
+// It does not modify the config, the mutation rules are compiled separately by CompileRules.
+func Validate(config *Config) field.ErrorList {
+	errs := validateNvidia(&config.Nvidia, field.NewPath("nvidia"))
+	for _, vendor := range []struct {
+		name                      string
+		count, memory, core, unit string
+		draConfig                 *VendorDRAConfig
+	}{
+		{"cambricon", config.Cambricon.ResourceCountName, config.Cambricon.ResourceMemoryName, config.Cambricon.ResourceCoreName, config.Cambricon.MemoryUnit, &config.Cambricon.DRA},
+		{"hygon", config.Hygon.ResourceCountName, config.Hygon.ResourceMemoryName, config.Hygon.ResourceCoreName, config.Hygon.MemoryUnit, &config.Hygon.DRA},
+		{"mthreads", config.Mthreads.ResourceCountName, config.Mthreads.ResourceMemoryName, config.Mthreads.ResourceCoreName, config.Mthreads.MemoryUnit, &config.Mthreads.DRA},
+	} {
+		errs = append(errs, validateSharedDevice(vendor.count, vendor.memory, vendor.core, vendor.unit, vendor.draConfig, field.NewPath(vendor.name))...)
+	}
+	errs = append(errs, validateMetax(&config.Metax, field.NewPath("metax"))...)
+	errs = append(errs, validateEnflame(&config.Enflame, field.NewPath("enflame"))...)
+	errs = append(errs, validateIluvatars(config.Iluvatars, field.NewPath("iluvatars"))...)
+	errs = append(errs, validateKunlun(&config.Kunlun, field.NewPath("kunlun"))...)
+	errs = append(errs, validateAWSNeuron(&config.AWSNeuron, field.NewPath("awsneuron"))...)
+	errs = append(errs, validateAMD(&config.AMD, field.NewPath("amd"))...)
+	errs = append(errs, validateVNPUs(config.VNPUs, field.NewPath("vnpus"))...)
+	errs = append(errs, validateAdmission(&config.Admission, field.NewPath("admission"))...)
+	errs = append(errs, validateRules(config.Rules, field.NewPath("rules"))...)
+	return errs
+}
+
+func validateAdmission(admissionConfig *AdmissionConfig, fldPath *field.Path) field.ErrorList {
+	var errs field.ErrorList
+
+	for _, selector := range []struct {
+		name  string
+		value *LabelSelector
+	}{
+		{"namespaceSelector", admissionConfig.NamespaceSelector},
+		{"excludeNamespaceSelector", admissionConfig.ExcludeNamespaceSelector},
+	} {
+		if _, err := selector.value.Selector(); err != nil {
+			errs = append(errs, field.Invalid(fldPath.Child(selector.name), selector.value, err.Error()))
+		}
+	}
+	return errs
+}
+
+func validateNvidia(nvidiaConfig *NvidiaConfig, fldPath *field.Path) field.ErrorList {
+	var errs field.ErrorList
+
+	if nvidiaConfig.ResourceCountName == "" {
+		errs = append(errs, field.Required(fldPath.Child("resourceCountName"), "the GPU count resource name is required"))
+	}
+	for _, resource := range []struct {
+		name  string
+		value string
+	}{
+		{"resourceCountName", nvidiaConfig.ResourceCountName},
+		{"resourceMemoryName", nvidiaConfig.ResourceMemoryName},
+		{"resourceCoreName", nvidiaConfig.ResourceCoreName},
+		{"resourceMemoryPercentageName", nvidiaConfig.ResourceMemoryPercentageName},
+		{"resourcePriorityName", nvidiaConfig.ResourcePriority},
+	} {
+		if resource.value == "" {
+			continue
+		}
+		if msgs := validation.IsQualifiedName(resource.value); len(msgs) > 0 {
+			errs = append(errs, field.Invalid(fldPath.Child(resource.name), resource.value, strings.Join(msgs, "; ")))
+		}
+	}
+
+	switch nvidiaConfig.SharedGPUCapacityPolicy {
+	case "", MaxCapacityPolicy, SumCapacityPolicy:
+	default:
+		errs = append(errs, field.NotSupported(fldPath.Child("sharedGPUCapacityPolicy"), nvidiaConfig.SharedGPUCapacityPolicy,
+			[]SharedGPUCapacityPolicy{MaxCapacityPolicy, SumCapacityPolicy}))
+	}
+	switch nvidiaConfig.GPUCorePolicy {
+	case "", DefaultCorePolicy, ForceCorePolicy, DisableCorePolicy:
+	default:
+		errs = append(errs, field.NotSupported(fldPath.Child("gpuCorePolicy"), nvidiaConfig.GPUCorePolicy,
+			[]GPUCoreUtilizationPolicy{DefaultCorePolicy, ForceCorePolicy, DisableCorePolicy}))
+	}
+
+	if nvidiaConfig.DefaultMemory < 0 {
+		errs = append(errs, field.Invalid(fldPath.Child("defaultMemory"), nvidiaConfig.DefaultMemory, "must be greater than or equal to 0"))
+	}
+	if nvidiaConfig.DefaultCores < 0 || nvidiaConfig.DefaultCores > 100 {
+		errs = append(errs, field.Invalid(fldPath.Child("defaultCores"), nvidiaConfig.DefaultCores, "must be between 0 and 100, inclusive"))
+	}
+	if nvidiaConfig.DefaultGPUNum < 0 {
+		errs = append(errs, field.Invalid(fldPath.Child("defaultGPUNum"), nvidiaConfig.DefaultGPUNum, "must be greater than or equal to 0"))
+	}
+
+	errs = append(errs, validateNodeDefaultConfig(&nvidiaConfig.NodeDefaultConfig, fldPath)...)
+	errs = append(errs, validateDRA(&nvidiaConfig.DRA, nvidiaConfig.ResourceCountName, fldPath.Child("dra"))...)
+	errs = append(errs, validateResourceAliases(nvidiaConfig, fldPath)...)
+	errs = append(errs, validateMigGeometries(nvidiaConfig.MigGeometriesList, fldPath.Child("knownMigGeometries"))...)
+	return errs
+}
+
+func validateResourceAliases(nvidiaConfig *NvidiaConfig, fldPath *field.Path) field.ErrorList {
+	var errs field.ErrorList
+
+	for i, preset := range nvidiaConfig.ResourceAliasPresets {
+		if _, ok := aliasPresets[preset]; !ok {
+			errs = append(errs, field.NotSupported(fldPath.Child("resourceAliasPresets").Index(i), preset, []string{VolcanoAliasPreset}))
+		}
+	}
+
+	// Every resource name must belong to a single alias, and not be one of the NVIDIA resources.
+	resourceNames := map[string]bool{
+		nvidiaConfig.ResourceCountName:  true,
+		nvidiaConfig.ResourceMemoryName: true,
+		nvidiaConfig.ResourceCoreName:   true,
+	}
+	for _, preset := range nvidiaConfig.ResourceAliasPresets {
+		alias := aliasPresets[preset]
+		for _, name := range []string{alias.ResourceCountName, alias.ResourceMemoryName, alias.ResourceCoreName} {
+			resourceNames[name] = true
+		}
+	}
+	for i, alias := range nvidiaConfig.ResourceAliases {
+		idxPath := fldPath.Child("resourceAliases").Index(i)
+		if alias.ResourceCountName == "" {
+			errs = append(errs, field.Required(idxPath.Child("resourceCountName"), "the GPU count resource name is required"))
+		}
+		for _, resource := range []struct {
+			name  string
+			value string
+		}{
+			{"resourceCountName", alias.ResourceCountName},
+			{"resourceMemoryName", alias.ResourceMemoryName},
+			{"resourceCoreName", alias.ResourceCoreName},
+		} {
+			if resource.value == "" {
+				continue
+			}
+			if msgs := validation.IsQualifiedName(resource.value); len(msgs) > 0 {
+				errs = append(errs, field.Invalid(idxPath.Child(resource.name), resource.value, strings.Join(msgs, "; ")))
+			} else if resourceNames[resource.value] {
+				errs = append(errs, field.Duplicate(idxPath.Child(resource.name), resource.value))
+			}
+			resourceNames[resource.value] = true
+		}
+		if unit, err := apiresource.ParseQuantity(alias.MemoryUnit); err != nil {
+			errs = append(errs, field.Invalid(idxPath.Child("memoryUnit"), alias.MemoryUnit, err.Error()))
+		} else if unit.Sign() <= 0 {
+			errs = append(errs, field.Invalid(idxPath.Child("memoryUnit"), alias.MemoryUnit, "must be greater than 0"))
+		}
+	}
+	return errs
+}
+
+func validateDRA(draConfig *DRAConfig, countName string, fldPath *field.Path) field.ErrorList {
+	var errs field.ErrorList
+
+	switch draConfig.Profile {
+	case HAMiDRAProfile, NvidiaDRAProfile:
+	default:
+		errs = append(errs, field.NotSupported(fldPath.Child("profile"), draConfig.Profile, []DRAProfile{HAMiDRAProfile, NvidiaDRAProfile}))
+	}
+	if draConfig.Profile == NvidiaDRAProfile {
+		if msgs := validation.IsDNS1123Subdomain(draConfig.MigDeviceClassName); len(msgs) > 0 {
+			errs = append(errs, field.Invalid(fldPath.Child("migDeviceClassName"), draConfig.MigDeviceClassName, strings.Join(msgs, "; ")))
+		}
+	}
+
+	for _, name := range []struct {
+		name  string
+		value string
+	}{
+		{"driverName", draConfig.DriverName},
+		{"deviceClassName", draConfig.DeviceClassName},
+	} {
+		if msgs := validation.IsDNS1123Subdomain(name.value); len(msgs) > 0 {
+			errs = append(errs, field.Invalid(fldPath.Child(name.name), name.value, strings.Join(msgs, "; ")))
+		}
+	}
+	if msgs := validation.IsDNS1123Label(draConfig.RequestName); len(msgs) > 0 {
+		errs = append(errs, field.Invalid(fldPath.Child("requestName"), draConfig.RequestName, strings.Join(msgs, "; ")))
+	}
+	for _, attribute := range []struct {
+		name  string
+		value string
+	}{
+		{"type", draConfig.Attributes.Type},
+		{"uuid", draConfig.Attributes.UUID},
+		{"productName", draConfig.Attributes.ProductName},
+	} {
+		if msgs := validation.IsCIdentifier(attribute.value); len(msgs) > 0 {
+			errs = append(errs, field.Invalid(fldPath.Child("attributes", attribute.name), attribute.value, strings.Join(msgs, "; ")))
+		}
+	}
+
+	resourceNames := map[string]bool{countName: true}
+	for i, deviceClass := range draConfig.DeviceClasses {
+		idxPath := fldPath.Child("deviceClasses").Index(i)
+		if msgs := validation.IsQualifiedName(deviceClass.ResourceName); len(msgs) > 0 {
+			errs = append(errs, field.Invalid(idxPath.Child("resourceName"), deviceClass.ResourceName, strings.Join(msgs, "; ")))
+		} else if resourceNames[deviceClass.ResourceName] {
+			errs = append(errs, field.Duplicate(idxPath.Child("resourceName"), deviceClass.ResourceName))
+		}
+		resourceNames[deviceClass.ResourceName] = true
+		if msgs := validation.IsDNS1123Subdomain(deviceClass.DeviceClassName); len(msgs) > 0 {
+			errs = append(errs, field.Invalid(idxPath.Child("deviceClassName"), deviceClass.DeviceClassName, strings.Join(msgs, "; ")))
+		}
+	}
+	return errs
+}
+
+func validateVendorDRA(draConfig *VendorDRAConfig, fldPath *field.Path) field.ErrorList {
+	var errs field.ErrorList
+
+	for _, name := range []struct {
+		name  string
+		value string
+	}{
+		{"driverName", draConfig.DriverName},
+		{"deviceClassName", draConfig.DeviceClassName},
+	} {
+		if msgs := validation.IsDNS1123Subdomain(name.value); len(msgs) > 0 {
+			errs = append(errs, field.Invalid(fldPath.Child(name.name), name.value, strings.Join(msgs, "; ")))
+		}
+	}
+	if msgs := validation.IsCIdentifier(draConfig.ProductNameAttribute); len(msgs) > 0 {
+		errs = append(errs, field.Invalid(fldPath.Child("productNameAttribute"), draConfig.ProductNameAttribute, strings.Join(msgs, "; ")))
+	}
+	return errs
+}
+
+// validateSharedDevice checks a vendor section following the count, memory and core pattern of NVIDIA,
+// if its resources are translated.
+func validateSharedDevice(countName, memoryName, coreName, memoryUnit string, draConfig *VendorDRAConfig, fldPath *field.Path) field.ErrorList {
+	var errs field.ErrorList
+
+	if !draConfig.Enabled() {
+		return errs
+	}
+	if countName == "" {
+		errs = append(errs, field.Required(fldPath.Child("resourceCountName"), "the count resource name is required to translate the resources"))
+	}
+	for _, resource := range []struct {
+		name  string
+		value string
+	}{
+		{"resourceCountName", countName},
+		{"resourceMemoryName", memoryName},
+		{"resourceCoreName", coreName},
+	} {
+		if resource.value == "" {
+			continue
+		}
+		if msgs := validation.IsQualifiedName(resource.value); len(msgs) > 0 {
+			errs = append(errs, field.Invalid(fldPath.Child(resource.name), resource.value, strings.Join(msgs, "; ")))
+		}
+	}
+	if unit, err := apiresource.ParseQuantity(memoryUnit); err != nil {
+		errs = append(errs, field.Invalid(fldPath.Child("memoryUnit"), memoryUnit, err.Error()))
+	} else if unit.Sign() <= 0 {
+		errs = append(errs, field.Invalid(fldPath.Child("memoryUnit"), memoryUnit, "must be greater than 0"))
+	}
+	errs = append(errs, validateVendorDRA(draConfig, fldPath.Child("dra"))...)
+	return errs
+}
+
+// validateMetax checks the MetaX section if its resources are translated.
+func validateMetax(metaxConfig *MetaxConfig, fldPath *field.Path) field.ErrorList {
+	var errs field.ErrorList
+
+	if !metaxConfig.DRA.Enabled() {
+		return errs
+	}
+	if metaxConfig.ResourceCountName == "" && metaxConfig.ResourceVCountName == "" {
+		errs = append(errs, field.Required(fldPath.Child("resourceVCountName"), "the GPU or sGPU count resource name is required to translate the resources"))
+	}
+	for _, resource := range []struct {
+		name  string
+		value string
+	}{
+		{"resourceCountName", metaxConfig.ResourceCountName},
+		{"resourceVCountName", metaxConfig.ResourceVCountName},
+		{"resourceVMemoryName", metaxConfig.ResourceVMemoryName},
+		{"resourceVCoreName", metaxConfig.ResourceVCoreName},
+	} {
+		if resource.value == "" {
+			continue
+		}
+		if msgs := validation.IsQualifiedName(resource.value); len(msgs) > 0 {
+			errs = append(errs, field.Invalid(fldPath.Child(resource.name), resource.value, strings.Join(msgs, "; ")))
+		}
+	}
+	if unit, err := apiresource.ParseQuantity(metaxConfig.MemoryUnit); err != nil {
+		errs = append(errs, field.Invalid(fldPath.Child("memoryUnit"), metaxConfig.MemoryUnit, err.Error()))
+	} else if unit.Sign() <= 0 {
+		errs = append(errs, field.Invalid(fldPath.Child("memoryUnit"), metaxConfig.MemoryUnit, "must be greater than 0"))
+	}
+	if msgs := validation.IsCIdentifier(metaxConfig.TopologyAttribute); len(msgs) > 0 {
+		errs = append(errs, field.Invalid(fldPath.Child("topologyAttribute"), metaxConfig.TopologyAttribute, strings.Join(msgs, "; ")))
+	}
+	errs = append(errs, validateVendorDRA(&metaxConfig.DRA, fldPath.Child("dra"))...)
+	return errs
+}
+
+// validateEnflame checks the Enflame section if its resources are translated.
+func validateEnflame(enflameConfig *EnflameConfig, fldPath *field.Path) field.ErrorList {
+	var errs field.ErrorList
+
+	if !enflameConfig.DRA.Enabled() {
+		return errs
+	}
+	if enflameConfig.ResourceNameGCU == "" && enflameConfig.ResourceNameVGCU == "" {
+		errs = append(errs, field.Required(fldPath.Child("resourceNameVGCU"), "the GCU or vGCU resource name is required to translate the resources"))
+	}
+	for _, resource := range []struct {
+		name  string
+		value string
+	}{
+		{"resourceNameGCU", enflameConfig.ResourceNameGCU},
+		{"resourceNameVGCU", enflameConfig.ResourceNameVGCU},
+		{"resourceNameVGCUPercentage", enflameConfig.ResourceNameVGCUPercentage},
+	} {
+		if resource.value == "" {
+			continue
+		}
+		if msgs := validation.IsQualifiedName(resource.value); len(msgs) > 0 {
+			errs = append(errs, field.Invalid(fldPath.Child(resource.name), resource.value, strings.Join(msgs, "; ")))
+		}
+	}
+	if msgs := validation.IsCIdentifier(enflameConfig.PercentageCapacity); len(msgs) > 0 {
+		errs = append(errs, field.Invalid(fldPath.Child("percentageCapacity"), enflameConfig.PercentageCapacity, strings.Join(msgs, "; ")))
+	}
+	errs = append(errs, validateVendorDRA(&enflameConfig.DRA, fldPath.Child("dra"))...)
+	return errs
+}
+
+// validateIluvatars checks the chips whose resources are translated, the others are only read by the HAMi scheduler.
+func validateIluvatars(iluvatars []IluvatarConfig, fldPath *field.Path) field.ErrorList {
+	var errs field.ErrorList
+
+	resourceNames := make(map[string]bool)
+	for i, iluvatar := range iluvatars {
+		if !iluvatar.DRA.Enabled() {
+			continue
+		}
+		idxPath := fldPath.Index(i)
+		if iluvatar.ChipName == "" {
+			errs = append(errs, field.Required(idxPath.Child("chipName"), ""))
+		}
+		if msgs := validation.IsDNS1123Label(strings.ToLower(iluvatar.CommonWord)); len(msgs) > 0 {
+			errs = append(errs, field.Invalid(idxPath.Child("commonWord"), iluvatar.CommonWord, strings.Join(msgs, "; ")))
+		}
+		for _, resource := range []struct {
+			name  string
+			value string
+		}{
+			{"resourceCountName", iluvatar.ResourceCountName},
+			{"resourceMemoryName", iluvatar.ResourceMemoryName},
+			{"resourceCoreName", iluvatar.ResourceCoreName},
+		} {
+			if resource.value == "" {
+				continue
+			}
+			if resourceNames[resource.value] {
+				errs = append(errs, field.Duplicate(idxPath.Child(resource.name), resource.value))
+			}
+			resourceNames[resource.value] = true
+		}
+		errs = append(errs, validateSharedDevice(iluvatar.ResourceCountName, iluvatar.ResourceMemoryName, iluvatar.ResourceCoreName,
+			iluvatar.MemoryUnit, &iluvatar.DRA, idxPath)...)
+	}
+	return errs
+}
+
+// validateKunlun checks the Kunlun section if its resources are translated.
+func validateKunlun(kunlunConfig *KunlunConfig, fldPath *field.Path) field.ErrorList {
+	var errs field.ErrorList
+
+	if !kunlunConfig.DRA.Enabled() {
+		return errs
+	}
+	if kunlunConfig.ResourceCountName == "" && kunlunConfig.ResourceVCountName == "" {
+		errs = append(errs, field.Required(fldPath.Child("resourceVCountName"), "the XPU or vXPU count resource name is required to translate the resources"))
+	}
+	for _, resource := range []struct {
+		name  string
+		value string
+	}{
+		{"resourceCountName", kunlunConfig.ResourceCountName},
+		{"resourceVCountName", kunlunConfig.ResourceVCountName},
+		{"resourceVMemoryName", kunlunConfig.ResourceVMemoryName},
+	} {
+		if resource.value == "" {
+			continue
+		}
+		if msgs := validation.IsQualifiedName(resource.value); len(msgs) > 0 {
+			errs = append(errs, field.Invalid(fldPath.Child(resource.name), resource.value, strings.Join(msgs, "; ")))
+		}
+	}
+	for i, size := range kunlunConfig.VXPUMemorySizes {
+		if size <= 0 {
+			errs = append(errs, field.Invalid(fldPath.Child("vxpuMemorySizes").Index(i), size, "must be greater than 0"))
+		} else if i > 0 && size <= kunlunConfig.VXPUMemorySizes[i-1] {
+			errs = append(errs, field.Invalid(fldPath.Child("vxpuMemorySizes").Index(i), size, "must be greater than the previous size"))
+		}
+	}
+	errs = append(errs, validateVendorDRA(&kunlunConfig.DRA, fldPath.Child("dra"))...)
+	return errs
+}
+
+// validateAWSNeuron checks the AWS Neuron section if its resources are translated.
+func validateAWSNeuron(neuronConfig *AWSNeuronConfig, fldPath *field.Path) field.ErrorList {
+	var errs field.ErrorList
+
+	if !neuronConfig.DRA.Enabled() {
+		return errs
+	}
+	if neuronConfig.ResourceCountName == "" && neuronConfig.ResourceCoreName == "" {
+		errs = append(errs, field.Required(fldPath.Child("resourceCoreName"), "the device or core resource name is required to translate the resources"))
+	}
+	for _, resource := range []struct {
+		name  string
+		value string
+	}{
+		{"resourceCountName", neuronConfig.ResourceCountName},
+		{"resourceCoreName", neuronConfig.ResourceCoreName},
+	} {
+		if resource.value == "" {
+			continue
+		}
+		if msgs := validation.IsQualifiedName(resource.value); len(msgs) > 0 {
+			errs = append(errs, field.Invalid(fldPath.Child(resource.name), resource.value, strings.Join(msgs, "; ")))
+		}
+	}
+	if msgs := validation.IsDNS1123Subdomain(neuronConfig.CoreDeviceClassName); len(msgs) > 0 {
+		errs = append(errs, field.Invalid(fldPath.Child("coreDeviceClassName"), neuronConfig.CoreDeviceClassName, strings.Join(msgs, "; ")))
+	}
+	for _, attribute := range []struct {
+		name     string
+		value    string
+		optional bool
+	}{
+		{"coreDeviceAttribute", neuronConfig.CoreDeviceAttribute, false},
+		{"topologyAttribute", neuronConfig.TopologyAttribute, true},
+	} {
+		if attribute.optional && attribute.value == "" {
+			continue
+		}
+		if msgs := validation.IsCIdentifier(attribute.value); len(msgs) > 0 {
+			errs = append(errs, field.Invalid(fldPath.Child(attribute.name), attribute.value, strings.Join(msgs, "; ")))
+		}
+	}
+	if neuronConfig.CoresPerDevice < 0 {
+		errs = append(errs, field.Invalid(fldPath.Child("coresPerDevice"), neuronConfig.CoresPerDevice, "must be greater than 0"))
+	}
+	errs = append(errs, validateVendorDRA(&neuronConfig.DRA, fldPath.Child("dra"))...)
+	return errs
+}
+
+// validateAMD checks the AMD section if its resources are translated.
+func validateAMD(amdConfig *AMDConfig, fldPath *field.Path) field.ErrorList {
+	var errs field.ErrorList
+
+	if !amdConfig.DRA.Enabled() {
+		return errs
+	}
+	if msgs := validation.IsQualifiedName(amdConfig.ResourceCountName); len(msgs) > 0 {
+		errs = append(errs, field.Invalid(fldPath.Child("resourceCountName"), amdConfig.ResourceCountName, strings.Join(msgs, "; ")))
+	}
+	errs = append(errs, validateVendorDRA(&amdConfig.DRA, fldPath.Child("dra"))...)
+	return errs
+}
+
+// validateVNPUs checks the chips whose resources are translated, the others are only read by the HAMi scheduler.
+func validateVNPUs(vnpus []VNPUConfig, fldPath *field.Path) field.ErrorList {
+	var errs field.ErrorList
+
+	resourceNames := make(map[string]bool)
+	for i, vnpu := range vnpus {
+		if !vnpu.DRA.Enabled() {
+			continue
+		}
+		idxPath := fldPath.Index(i)
+		if vnpu.ChipName == "" {
+			errs = append(errs, field.Required(idxPath.Child("chipName"), ""))
+		}
+		if msgs := validation.IsDNS1123Label(strings.ToLower(vnpu.CommonWord)); len(msgs) > 0 {
+			errs = append(errs, field.Invalid(idxPath.Child("commonWord"), vnpu.CommonWord, strings.Join(msgs, "; ")))
+		}
+		for _, resource := range []struct {
+			name  string
+			value string
+		}{
+			{"resourceName", vnpu.ResourceName},
+			{"resourceMemoryName", vnpu.ResourceMemoryName},
+		} {
+			if msgs := validation.IsQualifiedName(resource.value); len(msgs) > 0 {
+				errs = append(errs, field.Invalid(idxPath.Child(resource.name), resource.value, strings.Join(msgs, "; ")))
+			} else if resourceNames[resource.value] {
+				errs = append(errs, field.Duplicate(idxPath.Child(resource.name), resource.value))
+			}
+			resourceNames[resource.value] = true
+		}
+		if vnpu.MemoryCapacity <= 0 {
+			errs = append(errs, field.Invalid(idxPath.Child("memoryCapacity"), vnpu.MemoryCapacity, "must be greater than 0"))
+		}
+		if vnpu.MemoryAllocatable <= 0 || vnpu.MemoryAllocatable > vnpu.MemoryCapacity {
+			errs = append(errs, field.Invalid(idxPath.Child("memoryAllocatable"), vnpu.MemoryAllocatable, "must be greater than 0 and at most memoryCapacity"))
+		}
+
+		templates := make(map[string]bool)
+		for j, template := range vnpu.Templates {
+			tplPath := idxPath.Child("templates").Index(j)
+			if template.Name == "" {
+				errs = append(errs, field.Required(tplPath.Child("name"), ""))
+			} else if templates[template.Name] {
+				errs = append(errs, field.Duplicate(tplPath.Child("name"), template.Name))
+			}
+			templates[template.Name] = true
+			if template.Memory <= 0 || template.Memory > vnpu.MemoryCapacity {
+				errs = append(errs, field.Invalid(tplPath.Child("memory"), template.Memory, "must be greater than 0 and at most memoryCapacity"))
+			}
+		}
+		errs = append(errs, validateVendorDRA(&vnpu.DRA, idxPath.Child("dra"))...)
+	}
+	return errs
+}
+
+func validateNodeDefaultConfig(nodeConfig *NodeDefaultConfig, fldPath *field.Path) field.ErrorList {
+	var errs field.ErrorList
+
+	if nodeConfig.DeviceSplitCount != nil && *nodeConfig.DeviceSplitCount == 0 {
+		errs = append(errs, field.Invalid(fldPath.Child("deviceSplitCount"), *nodeConfig.DeviceSplitCount, "must be greater than 0"))
+	}
+	if nodeConfig.DeviceMemoryScaling != nil && *nodeConfig.DeviceMemoryScaling <= 0 {
+		errs = append(errs, field.Invalid(fldPath.Child("deviceMemoryScaling"), *nodeConfig.DeviceMemoryScaling, "must be greater than 0"))
+	}
+	if nodeConfig.DeviceCoreScaling != nil && *nodeConfig.DeviceCoreScaling <= 0 {
+		errs = append(errs, field.Invalid(fldPath.Child("deviceCoreScaling"), *nodeConfig.DeviceCoreScaling, "must be greater than 0"))
+	}
+	if nodeConfig.LogLevel != nil {
+		switch *nodeConfig.LogLevel {
+		case Error, Warnings, Infos, Debugs:
+		default:
+			errs = append(errs, field.NotSupported(fldPath.Child("libCudaLogLevel"), *nodeConfig.LogLevel,
+				[]LibCudaLogLevel{Error, Warnings, Infos, Debugs}))
+		}
+	}
+	return errs
+}
+
+func validateMigGeometries(list []AllowedMigGeometries, fldPath *field.Path) field.ErrorList {
+	var errs field.ErrorList
+
+	// A model may be listed by several entries, its allowed geometries are those of all of them
+	// and must be unique across them.
+	geometries := make(map[string]map[string]*field.Path)
+	for i, allowed := range list {
+		idxPath := fldPath.Index(i)
+		if len(allowed.Models) == 0 {
+			errs = append(errs, field.Required(idxPath.Child("models"), "at least one model is required"))
+		}
+
+		for j, geometry := range allowed.Geometries {
+			geoPath := idxPath.Child("allowedGeometries").Index(j)
+			if len(geometry) == 0 {
+				errs = append(errs, field.Required(geoPath, "a geometry needs at least one template"))
+				continue
+			}
+			key := fmt.Sprint(geometry)
+			for _, model := range sets.List(sets.New(allowed.Models...)) {
+				if geometries[model] == nil {
+					geometries[model] = make(map[string]*field.Path)
+				}
+				if previous, ok := geometries[model][key]; ok {
+					errs = append(errs, field.Duplicate(geoPath, fmt.Sprintf("model %s has the same geometry at %s", model, previous)))
+				} else {
+					geometries[model][key] = geoPath
+				}
+			}
+			for k, template := range geometry {
+				tplPath := geoPath.Index(k)
+				if template.Name == "" {
+					errs = append(errs, field.Required(tplPath.Child("name"), ""))
+				}
+				if template.Memory <= 0 {
+					errs = append(errs, field.Invalid(tplPath.Child("memory"), template.Memory, "must be greater than 0"))
+				}
+				if template.Count <= 0 {
+					errs = append(errs, field.Invalid(tplPath.Child("count"), template.Count, "must be greater than 0"))
+				}
+				if template.Core < 0 || template.Core > 100 {
+					errs = append(errs, field.Invalid(tplPath.Child("core"), template.Core, "must be between 0 and 100, inclusive"))
+				}
+			}
+		}
+	}
+	return errs
+}
+
+// LoadFile reads the device config file and parses it with Parse.
+func LoadFile(path string, strict bool) (*Config, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read device config file %s: %w", path, err)
+	}
+	return Parse(data, strict)
+}
+
+// Parse parses the device config, fills its unset fields with SetDefaults, validates the result and compiles its rules.
+// Unset fields, such as an empty resource name, therefore mean the default value rather than none.
+func Parse(data []byte, strict bool) (*Config, error) {
+	config, err := UnmarshalConfig(data, strict)
+	if err != nil {
+		return nil, fmt.Errorf("failed to unmarshal device config: %w", err)
+	}
+	SetDefaults(config)
+	if errs := Validate(config); len(errs) > 0 {
+		return nil, fmt.Errorf("invalid device config: %w", errs.ToAggregate())
+	}
+	if err := CompileRules(config.Rules); err != nil {
+		return nil, err
+	}
+	return config, nil
+}
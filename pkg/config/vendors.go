@@ -0,0 +1,171 @@
+/*
+Copyright 2025 The HAMi Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package config
+
+// VendorDRAConfig names the DRA driver the claims of a vendor are written for.
+// The resources of a vendor are only translated once its driver is set, other vendors keep their device plugins.
+// Memory resources are requested from the driver in bytes, using the MemoryUnit of the vendor, and cores as they are.
+type VendorDRAConfig struct {
+	// DriverName is the DRA driver publishing the devices of the vendor, translation is disabled if empty.
+	DriverName string `yaml:"driverName,omitempty"`
+	// DeviceClassName is the DeviceClass requested by the claims, defaults to the driver name.
+	DeviceClassName string `yaml:"deviceClassName,omitempty"`
+	// ProductNameAttribute is the device attribute holding the product or chip name, defaults to productName.
+	ProductNameAttribute string `yaml:"productNameAttribute,omitempty"`
+}
+
+// Enabled reports whether the resources of the vendor are translated.
+func (c *VendorDRAConfig) Enabled() bool {
+	return c.DriverName != ""
+}
+
+// CambriconConfig is the device config of Cambricon MLUs.
+type CambriconConfig struct {
+	ResourceCountName  string `yaml:"resourceCountName"`
+	ResourceMemoryName string `yaml:"resourceMemoryName"`
+	ResourceCoreName   string `yaml:"resourceCoreName"`
+	// MemoryUnit is the quantity of one unit of the memory resource, defaults to the unit of the device plugin, in units of 256MiB.
+	MemoryUnit string `yaml:"memoryUnit,omitempty"`
+	// DRA translates the MLU count, memory and cores into claims.
+	DRA VendorDRAConfig `yaml:"dra,omitempty"`
+}
+
+// HygonConfig is the device config of Hygon DCUs.
+type HygonConfig struct {
+	ResourceCountName  string `yaml:"resourceCountName"`
+	ResourceMemoryName string `yaml:"resourceMemoryName"`
+	ResourceCoreName   string `yaml:"resourceCoreName"`
+	// MemoryUnit is the quantity of one unit of the memory resource, defaults to the unit of the device plugin, in MiB.
+	MemoryUnit string `yaml:"memoryUnit,omitempty"`
+	// DRA translates the DCU count, memory and cores into claims.
+	DRA VendorDRAConfig `yaml:"dra,omitempty"`
+}
+
+// MetaxConfig is the device config of MetaX GPUs and sGPUs.
+type MetaxConfig struct {
+	ResourceCountName   string `yaml:"resourceCountName"`
+	ResourceVCountName  string `yaml:"resourceVCountName"`
+	ResourceVMemoryName string `yaml:"resourceVMemoryName"`
+	ResourceVCoreName   string `yaml:"resourceVCoreName"`
+	// SGPUTopologyAware keeps the GPUs or sGPUs of a multi-device request on the same interconnect domain.
+	SGPUTopologyAware bool `yaml:"sgpuTopologyAware"`
+	// MemoryUnit is the quantity of one unit of the sGPU memory resource, defaults to 1Gi like the device plugin.
+	MemoryUnit string `yaml:"memoryUnit,omitempty"`
+	// TopologyAttribute is the device attribute naming the interconnect domain of a GPU, defaults to interconnectDomain.
+	TopologyAttribute string `yaml:"topologyAttribute,omitempty"`
+	// DRA enables the translation of the GPU and sGPU resources into claims.
+	DRA VendorDRAConfig `yaml:"dra,omitempty"`
+}
+
+// EnflameConfig is the device config of Enflame GCUs.
+type EnflameConfig struct {
+	ResourceNameGCU            string `yaml:"resourceNameGCU"`
+	ResourceNameVGCU           string `yaml:"resourceNameVGCU"`
+	ResourceNameVGCUPercentage string `yaml:"resourceNameVGCUPercentage"`
+	// PercentageCapacity is the consumable capacity of a GCU the vGCU percentage is requested from, defaults to percentage.
+	PercentageCapacity string `yaml:"percentageCapacity,omitempty"`
+	// DRA enables the translation of the GCU and vGCU resources into claims.
+	DRA VendorDRAConfig `yaml:"dra,omitempty"`
+}
+
+// MthreadsConfig is the device config of Moore Threads GPUs.
+type MthreadsConfig struct {
+	ResourceCountName  string `yaml:"resourceCountName"`
+	ResourceMemoryName string `yaml:"resourceMemoryName"`
+	ResourceCoreName   string `yaml:"resourceCoreName"`
+	// MemoryUnit is the quantity of one unit of the memory resource, defaults to the unit of the device plugin, in units of 512MiB.
+	MemoryUnit string `yaml:"memoryUnit,omitempty"`
+	// DRA translates the GPU count, memory and cores into claims.
+	DRA VendorDRAConfig `yaml:"dra,omitempty"`
+}
+
+// IluvatarConfig is the device config of one Iluvatar chip family.
+type IluvatarConfig struct {
+	ChipName           string `yaml:"chipName"`
+	CommonWord         string `yaml:"commonWord"`
+	ResourceCountName  string `yaml:"resourceCountName"`
+	ResourceMemoryName string `yaml:"resourceMemoryName"`
+	ResourceCoreName   string `yaml:"resourceCoreName"`
+	// MemoryUnit is the quantity of one unit of the vMem resource, defaults to the unit of the device plugin, in units of 256MiB.
+	MemoryUnit string `yaml:"memoryUnit,omitempty"`
+	// DRA enables the translation of the chip's resources into claims pinned to the chip by its product name.
+	DRA VendorDRAConfig `yaml:"dra,omitempty"`
+}
+
+// KunlunConfig is the device config of Kunlunxin XPUs and vXPUs.
+type KunlunConfig struct {
+	ResourceCountName   string `yaml:"resourceCountName"`
+	ResourceVCountName  string `yaml:"resourceVCountName"`
+	ResourceVMemoryName string `yaml:"resourceVMemoryName"`
+	// VXPUMemorySizes are the memory sizes in MiB of the partitions a vXPU can be, in increasing order.
+	// vXPU memory requests are rounded up to the smallest of them, defaults to the quarter, half and whole of a P800.
+	VXPUMemorySizes []int64 `yaml:"vxpuMemorySizes,omitempty"`
+	// DRA enables the translation of the XPU and vXPU resources into claims.
+	DRA VendorDRAConfig `yaml:"dra,omitempty"`
+}
+
+// AWSNeuronConfig is the device config of AWS Neuron devices and cores.
+type AWSNeuronConfig struct {
+	ResourceCountName string `yaml:"resourceCountName"`
+	ResourceCoreName  string `yaml:"resourceCoreName"`
+	// CoreDeviceClassName is the DeviceClass of the NeuronCores published by the driver, defaults to neuroncore.<driverName>.
+	CoreDeviceClassName string `yaml:"coreDeviceClassName,omitempty"`
+	// CoreDeviceAttribute is the attribute of a NeuronCore naming its Neuron device, defaults to deviceIndex.
+	// The cores of a multi-core request must have the same value.
+	CoreDeviceAttribute string `yaml:"coreDeviceAttribute,omitempty"`
+	// TopologyAttribute is the attribute shared by adjacent Neuron devices, such as a ring of connected devices.
+	// If set, multi-core and multi-device requests must have the same value instead of sitting on one device.
+	TopologyAttribute string `yaml:"topologyAttribute,omitempty"`
+	// CoresPerDevice is the number of NeuronCores of a Neuron device, defaults to 8, the most of any Neuron device.
+	// Without TopologyAttribute, requests of more cores are rejected as they cannot sit on one device.
+	CoresPerDevice int32 `yaml:"coresPerDevice,omitempty"`
+	// DRA enables the translation of the Neuron device and core resources into claims.
+	DRA VendorDRAConfig `yaml:"dra,omitempty"`
+}
+
+// AMDConfig is the device config of AMD GPUs.
+type AMDConfig struct {
+	ResourceCountName string `yaml:"resourceCountName"`
+	// DRA enables the translation of the GPU resource into claims of whole GPUs.
+	DRA VendorDRAConfig `yaml:"dra,omitempty"`
+}
+
+// VNPUConfig is the device config of one Huawei Ascend chip and its virtualization templates.
+type VNPUConfig struct {
+	ChipName           string `yaml:"chipName"`
+	CommonWord         string `yaml:"commonWord"`
+	ResourceName       string `yaml:"resourceName"`
+	ResourceMemoryName string `yaml:"resourceMemoryName"`
+	// MemoryAllocatable is the memory in MiB that can be handed out to vNPUs.
+	MemoryAllocatable int64 `yaml:"memoryAllocatable"`
+	// MemoryCapacity is the physical memory of the chip in MiB.
+	MemoryCapacity int64          `yaml:"memoryCapacity"`
+	AICore         int32          `yaml:"aiCore"`
+	AICPU          int32          `yaml:"aiCPU"`
+	Templates      []VNPUTemplate `yaml:"templates"`
+	// DRA enables the translation of the chip's resources into claims, vNPUs get the smallest template holding the requested memory.
+	DRA VendorDRAConfig `yaml:"dra,omitempty"`
+}
+
+// VNPUTemplate is a named virtualization template of an Ascend chip.
+type VNPUTemplate struct {
+	Name string `yaml:"name"`
+	// Memory is the memory in MiB provided by the template.
+	Memory int64 `yaml:"memory"`
+	AICore int32 `yaml:"aiCore"`
+	AICPU  int32 `yaml:"aiCPU"`
+}
@@ -0,0 +1,122 @@
+/*
+Copyright 2025 The HAMi Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package config
+
+import (
+	"bytes"
+	"context"
+	"os"
+	"path/filepath"
+	"sync/atomic"
+
+	"github.com/fsnotify/fsnotify"
+	"k8s.io/klog/v2"
+)
+
+// Store holds the active device config, which can be swapped atomically while requests are in flight.
+type Store struct {
+	current atomic.Pointer[Config]
+}
+
+// NewStore returns a Store holding the given config.
+func NewStore(config *Config) *Store {
+	s := &Store{}
+	s.current.Store(config)
+	return s
+}
+
+// Load returns the active config. Callers must treat it as read-only.
+func (s *Store) Load() *Config {
+	return s.current.Load()
+}
+
+// Store replaces the active config.
+func (s *Store) Store(config *Config) {
+	s.current.Store(config)
+}
+
+// Watcher reloads the device config file into a Store whenever the file changes.
+// The parent directory is watched rather than the file itself, so the symlink swaps
+// done by kubelet when updating ConfigMap volumes are noticed as well.
+type Watcher struct {
+	Path  string
+	Store *Store
+	// Strict rejects configs with unknown fields.
+	Strict bool
+	// OnReload is called after every reload attempt, with the error if the new config was rejected.
+	OnReload func(err error)
+	// Loaded is the file content the config in Store was parsed from, the file is reloaded once it differs.
+	Loaded []byte
+}
+
+// NeedLeaderElection implements the LeaderElectionRunnable interface, every replica reloads its own config.
+func (w *Watcher) NeedLeaderElection() bool {
+	return false
+}
+
+// Start watches the config file until the context is done.
+func (w *Watcher) Start(ctx context.Context) error {
+	watcher, err := fsnotify.NewWatcher()
+	if err != nil {
+		return err
+	}
+	defer watcher.Close()
+
+	if err := watcher.Add(filepath.Dir(w.Path)); err != nil {
+		return err
+	}
+	klog.Infof("Watching device config file %s for changes", w.Path)
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case event, ok := <-watcher.Events:
+			if !ok {
+				return nil
+			}
+			klog.V(5).Infof("Device config directory event: %s", event.String())
+			w.reload()
+		case err, ok := <-watcher.Errors:
+			if !ok {
+				return nil
+			}
+			klog.Errorf("Error watching device config file %s: %v", w.Path, err)
+		}
+	}
+}
+
+// reload loads the config file if its content changed, keeping the last good config on errors.
+func (w *Watcher) reload() {
+	data, err := os.ReadFile(w.Path)
+	if err != nil || bytes.Equal(data, w.Loaded) {
+		// The file is briefly missing during a symlink swap, the next event picks up the new content.
+		return
+	}
+	w.Loaded = data
+
+	config, err := Parse(data, w.Strict)
+	if err != nil {
+		klog.Errorf("Rejected device config update, keeping the last good config: %v", err)
+	} else {
+		w.Store.Store(config)
+		klog.Infof("Reloaded device config from %s", w.Path)
+	}
+	if w.OnReload != nil {
+		w.OnReload(err)
+	}
+}
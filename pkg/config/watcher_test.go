@@ -0,0 +1,115 @@
+/*
+Copyright 2025 The HAMi Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package config
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+// writeConfigMapVolume lays out a file the way kubelet does for ConfigMap volumes:
+// the file is a symlink to ..data/<file>, and ..data is a symlink swapped on every update.
+func writeConfigMapVolume(t *testing.T, dir, version, content string) {
+	t.Helper()
+	versionDir := filepath.Join(dir, version)
+	if err := os.Mkdir(versionDir, 0o755); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(filepath.Join(versionDir, DeviceConfigFileName), []byte(content), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	tmpLink := filepath.Join(dir, "..data_tmp")
+	if err := os.Symlink(version, tmpLink); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.Rename(tmpLink, filepath.Join(dir, "..data")); err != nil {
+		t.Fatal(err)
+	}
+	link := filepath.Join(dir, DeviceConfigFileName)
+	if _, err := os.Lstat(link); os.IsNotExist(err) {
+		if err := os.Symlink(filepath.Join("..data", DeviceConfigFileName), link); err != nil {
+			t.Fatal(err)
+		}
+	}
+}
+
+func TestWatcherReload(t *testing.T) {
+	dir := t.TempDir()
+	path := filepath.Join(dir, DeviceConfigFileName)
+	writeConfigMapVolume(t, dir, "v1", "nvidia:\n  resourceCountName: nvidia.com/gpu\n")
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatalf("No error is expected but got: %v", err)
+	}
+	initial, err := Parse(data, true)
+	if err != nil {
+		t.Fatalf("No error is expected but got: %v", err)
+	}
+	results := make(chan error, 10)
+	w := &Watcher{Path: path, Store: NewStore(initial), Strict: true, Loaded: data, OnReload: func(err error) { results <- err }}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	go func() { _ = w.Start(ctx) }()
+
+	// The update may land before the directory is watched, waitReload notices it with the next event.
+	writeConfigMapVolume(t, dir, "v2", "nvidia:\n  resourceCountName: hami.io/gpu\n")
+	if err := waitReload(t, dir, results); err != nil {
+		t.Fatalf("No error is expected but got: %v", err)
+	}
+	if got := w.Store.Load().Nvidia.ResourceCountName; got != "hami.io/gpu" {
+		t.Fatalf("expect resourceCountName: hami.io/gpu, but got: %s", got)
+	}
+
+	writeConfigMapVolume(t, dir, "v3", "nvidia:\n  resourceCountName: nvidia.com/gpu/invalid\n")
+	if err := waitReload(t, dir, results); err == nil {
+		t.Fatalf("Expect error, but got nil")
+	}
+	writeConfigMapVolume(t, dir, "v4", "nvidia:\n  resourceCountName: nvidia.com/gpu\n  resourceCoreNmae: nvidia.com/gpucores\n")
+	if err := waitReload(t, dir, results); err == nil {
+		t.Fatalf("Expect error for unknown field, but got nil")
+	}
+	if got := w.Store.Load().Nvidia.ResourceCountName; got != "hami.io/gpu" {
+		t.Fatalf("expect the last good resourceCountName: hami.io/gpu, but got: %s", got)
+	}
+}
+
+// waitReload waits for the next reload result. Until it comes, it keeps touching a file in dir,
+// whose events make the watcher compare the config file again once it watches the directory.
+func waitReload(t *testing.T, dir string, results <-chan error) error {
+	t.Helper()
+	deadline := time.After(5 * time.Second)
+	ticker := time.NewTicker(20 * time.Millisecond)
+	defer ticker.Stop()
+	for {
+		select {
+		case err := <-results:
+			return err
+		case <-ticker.C:
+			if err := os.WriteFile(filepath.Join(dir, "..touch"), nil, 0o644); err != nil {
+				t.Fatal(err)
+			}
+		case <-deadline:
+			t.Fatalf("timed out waiting for the device config to be reloaded")
+			return nil
+		}
+	}
+}
@@ -0,0 +1,128 @@
+/*
+Copyright 2025 The HAMi Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by config-descriptions. DO NOT EDIT.
+
+package config
+
+// descriptions are the doc comments of the types and fields of the package, keyed by type or type.field.
+var descriptions = map[string]string{
+	"AMDConfig":                                "AMDConfig is the device config of AMD GPUs.",
+	"AMDConfig.DRA":                            "DRA enables the translation of the GPU resource into claims of whole GPUs.",
+	"AWSNeuronConfig":                          "AWSNeuronConfig is the device config of AWS Neuron devices and cores.",
+	"AWSNeuronConfig.CoreDeviceAttribute":      "CoreDeviceAttribute is the attribute of a NeuronCore naming its Neuron device, defaults to deviceIndex. The cores of a multi-core request must have the same value.",
+	"AWSNeuronConfig.CoreDeviceClassName":      "CoreDeviceClassName is the DeviceClass of the NeuronCores published by the driver, defaults to neuroncore.<driverName>.",
+	"AWSNeuronConfig.CoresPerDevice":           "CoresPerDevice is the number of NeuronCores of a Neuron device, defaults to 8, the most of any Neuron device. Without TopologyAttribute, requests of more cores are rejected as they cannot sit on one device.",
+	"AWSNeuronConfig.DRA":                      "DRA enables the translation of the Neuron device and core resources into claims.",
+	"AWSNeuronConfig.TopologyAttribute":        "TopologyAttribute is the attribute shared by adjacent Neuron devices, such as a ring of connected devices. If set, multi-core and multi-device requests must have the same value instead of sitting on one device.",
+	"AdmissionConfig":                          "AdmissionConfig selects the pods translated by the webhook.",
+	"AdmissionConfig.ExcludeNamespaceSelector": "ExcludeNamespaceSelector opts namespaces out, the pods of matching namespaces are never translated.",
+	"AdmissionConfig.NamespaceSelector":        "NamespaceSelector opts namespaces in, only the pods of matching namespaces are translated. All namespaces are translated if it is not set.",
+	"CambriconConfig":                          "CambriconConfig is the device config of Cambricon MLUs.",
+	"CambriconConfig.DRA":                      "DRA translates the MLU count, memory and cores into claims.",
+	"CambriconConfig.MemoryUnit":               "MemoryUnit is the quantity of one unit of the memory resource, defaults to the unit of the device plugin, in units of 256MiB.",
+	"Config":                                   "Config is the device config shared with the HAMi scheduler, one section per vendor.",
+	"Config.Admission":                         "Admission selects the pods translated by the webhook, it is not part of the HAMi scheduler config.",
+	"Config.Rules":                             "Rules customize the ResourceClaims generated for matching pods, they are not part of the HAMi scheduler config.",
+	"DRAAttributes":                            "DRAAttributes are the names of the device attributes used in the generated selectors.",
+	"DRAAttributes.ProductName":                "ProductName is the attribute holding the product name, matched against the nvidia.com/use-gputype annotation.",
+	"DRAAttributes.Type":                       "Type is the attribute holding the device type.",
+	"DRAAttributes.UUID":                       "UUID is the attribute holding the device UUID, matched against the nvidia.com/use-gpuuuid annotation.",
+	"DRAConfig":                                "DRAConfig describes the DRA driver the generated ResourceClaims are written for.",
+	"DRAConfig.Attributes":                     "Attributes are the names of the device attributes published by the driver.",
+	"DRAConfig.DeviceClassName":                "DeviceClassName is the DeviceClass requested for the count resource, defaults to the driver name.",
+	"DRAConfig.DeviceClasses":                  "DeviceClasses map additional count resource names to their own DeviceClass, for example a resource only requesting A100 GPUs.",
+	"DRAConfig.DeviceType":                     "DeviceType is the value of the device type attribute the claims select, no type selector is added if empty.",
+	"DRAConfig.DriverName":                     "DriverName is the name of the DRA driver, it qualifies the device attributes in selectors.",
+	"DRAConfig.MigDeviceClassName":             "MigDeviceClassName is the DeviceClass requested by pods in MIG mode with the nvidia profile.",
+	"DRAConfig.Profile":                        "Profile is the translation model of the driver, defaults to hami-core. It also decides the defaults of the other fields.",
+	"DRAConfig.RequestName":                    "RequestName is the name of the device request in the generated claims.",
+	"DRAProfile":                               "DRAProfile is the translation model of the DRA driver.",
+	"EnflameConfig":                            "EnflameConfig is the device config of Enflame GCUs.",
+	"EnflameConfig.DRA":                        "DRA enables the translation of the GCU and vGCU resources into claims.",
+	"EnflameConfig.PercentageCapacity":         "PercentageCapacity is the consumable capacity of a GCU the vGCU percentage is requested from, defaults to percentage.",
+	"FilterDevice.Index":                       "Index is the device index.",
+	"FilterDevice.UUID":                        "UUID is the device ID.",
+	"GPUCoreUtilizationPolicy":                 "GPUCoreUtilizationPolicy is set nvidia gpu core isolation policy.",
+	"HygonConfig":                              "HygonConfig is the device config of Hygon DCUs.",
+	"HygonConfig.DRA":                          "DRA translates the DCU count, memory and cores into claims.",
+	"HygonConfig.MemoryUnit":                   "MemoryUnit is the quantity of one unit of the memory resource, defaults to the unit of the device plugin, in MiB.",
+	"IluvatarConfig":                           "IluvatarConfig is the device config of one Iluvatar chip family.",
+	"IluvatarConfig.DRA":                       "DRA enables the translation of the chip's resources into claims pinned to the chip by its product name.",
+	"IluvatarConfig.MemoryUnit":                "MemoryUnit is the quantity of one unit of the vMem resource, defaults to the unit of the device plugin, in units of 256MiB.",
+	"JSONSchema":                               "JSONSchema is the subset of JSON Schema needed to describe the device config.",
+	"KunlunConfig":                             "KunlunConfig is the device config of Kunlunxin XPUs and vXPUs.",
+	"KunlunConfig.DRA":                         "DRA enables the translation of the XPU and vXPU resources into claims.",
+	"KunlunConfig.VXPUMemorySizes":             "VXPUMemorySizes are the memory sizes in MiB of the partitions a vXPU can be, in increasing order. vXPU memory requests are rounded up to the smallest of them, defaults to the quarter, half and whole of a P800.",
+	"LabelSelector":                            "LabelSelector is the yaml form of a metav1.LabelSelector.",
+	"LabelSelectorRequirement":                 "LabelSelectorRequirement is the yaml form of a metav1.LabelSelectorRequirement.",
+	"MetaxConfig":                              "MetaxConfig is the device config of MetaX GPUs and sGPUs.",
+	"MetaxConfig.DRA":                          "DRA enables the translation of the GPU and sGPU resources into claims.",
+	"MetaxConfig.MemoryUnit":                   "MemoryUnit is the quantity of one unit of the sGPU memory resource, defaults to 1Gi like the device plugin.",
+	"MetaxConfig.SGPUTopologyAware":            "SGPUTopologyAware keeps the GPUs or sGPUs of a multi-device request on the same interconnect domain.",
+	"MetaxConfig.TopologyAttribute":            "TopologyAttribute is the device attribute naming the interconnect domain of a GPU, defaults to interconnectDomain.",
+	"MigConfigSpec":                            "MigConfigSpec defines the spec to declare the desired MIG configuration for a set of GPUs.",
+	"MigConfigSpecSlice":                       "MigConfigSpecSlice represents a slice of 'MigConfigSpec'.",
+	"MigTemplate.Core":                         "Core is the share of the GPU's compute, in percent, given to an instance of this template.",
+	"MthreadsConfig":                           "MthreadsConfig is the device config of Moore Threads GPUs.",
+	"MthreadsConfig.DRA":                       "DRA translates the GPU count, memory and cores into claims.",
+	"MthreadsConfig.MemoryUnit":                "MemoryUnit is the quantity of one unit of the memory resource, defaults to the unit of the device plugin, in units of 512MiB.",
+	"MutationRule":                             "MutationRule adds selectors, constraints and capacity defaults to the ResourceClaims generated for matching pods.",
+	"MutationRule.Capacity":                    "Capacity are the capacities requested by generated claims that do not request them already, such as memory or cores.",
+	"MutationRule.Constraints":                 "Constraints are the attributes that all devices of a generated claim must share.",
+	"MutationRule.FailurePolicy":               "FailurePolicy decides whether a pod the Match expression fails to be evaluated against, such as one without a field it reads, is rejected or translated as if the rule did not match, defaults to Fail.",
+	"MutationRule.Match":                       "Match is a CEL expression over the pod, available as the `pod` variable, that returns whether the rule applies.",
+	"MutationRule.Name":                        "Name identifies the rule in errors and logs.",
+	"MutationRule.Selectors":                   "Selectors are CEL device selectors added to the generated claims.",
+	"MutationRule.Translators":                 "Translators are the device translators, such as nvidia or cambricon, whose requests the rule changes. The rule changes the requests of every translator if empty.",
+	"NodeDefaultConfig":                        "These configs can be sepecified for each node by using Nodeconfig.",
+	"NodeDefaultConfig.LogLevel":               "LogLevel is LIBCUDA_LOG_LEVEL value",
+	"NvidiaConfig.DRA":                         "DRA describes the DRA driver the generated ResourceClaims are written for, defaults to the HAMi driver.",
+	"NvidiaConfig.DisableCoreLimit":            "TODO Whether these should be removed",
+	"NvidiaConfig.GPUCorePolicy":               "GPUCorePolicy through webhook automatic injected to container env",
+	"NvidiaConfig.ResourceAliasPresets":        "ResourceAliasPresets enables built-in resource aliases, such as volcano.",
+	"NvidiaConfig.ResourceAliases":             "ResourceAliases are other names of the GPU resources, translated into the same ResourceClaims.",
+	"NvidiaConfig.RuntimeClassName":            "RuntimeClassName is the name of the runtime class to be added to pod.spec.runtimeClassName",
+	"NvidiaConfig.SharedGPUCapacityPolicy":     "SharedGPUCapacityPolicy decides the capacity of a GPU claim shared by several containers, defaults to max.",
+	"ResourceAlias":                            "ResourceAlias names GPU resources translated like the NVIDIA count, memory and core resources.",
+	"ResourceAlias.MemoryUnit":                 "MemoryUnit is the quantity of one unit of the memory resource, defaults to 1Mi like HAMi.",
+	"ResourceAlias.Name":                       "Name identifies the alias in errors and logs.",
+	"ResourceAlias.ResourceCoreName":           "ResourceCoreName is an alias of the resource requesting a percentage of GPU cores.",
+	"ResourceAlias.ResourceCountName":          "ResourceCountName is an alias of the resource requesting a number of GPUs.",
+	"ResourceAlias.ResourceMemoryName":         "ResourceMemoryName is an alias of the resource requesting GPU memory.",
+	"ResourceDeviceClass":                      "ResourceDeviceClass maps a count resource name to a DeviceClass.",
+	"ResourceDeviceClass.DeviceClassName":      "DeviceClassName is the DeviceClass requested for the resource.",
+	"ResourceDeviceClass.ResourceName":         "ResourceName is the extended resource name requesting a number of devices of the class.",
+	"RuleConstraint":                           "RuleConstraint is a constraint added to the generated claims.",
+	"RuleConstraint.MatchAttribute":            "MatchAttribute is the fully qualified name of the device attribute all devices must share.",
+	"RuleFailurePolicy":                        "RuleFailurePolicy decides what happens to a pod a rule fails to be evaluated against.",
+	"SharedGPUCapacityPolicy":                  "SharedGPUCapacityPolicy decides how the capacity of a shared GPU group is derived from its containers.",
+	"Store":                                    "Store holds the active device config, which can be swapped atomically while requests are in flight.",
+	"VNPUConfig":                               "VNPUConfig is the device config of one Huawei Ascend chip and its virtualization templates.",
+	"VNPUConfig.DRA":                           "DRA enables the translation of the chip's resources into claims, vNPUs get the smallest template holding the requested memory.",
+	"VNPUConfig.MemoryAllocatable":             "MemoryAllocatable is the memory in MiB that can be handed out to vNPUs.",
+	"VNPUConfig.MemoryCapacity":                "MemoryCapacity is the physical memory of the chip in MiB.",
+	"VNPUTemplate":                             "VNPUTemplate is a named virtualization template of an Ascend chip.",
+	"VNPUTemplate.Memory":                      "Memory is the memory in MiB provided by the template.",
+	"VendorDRAConfig":                          "VendorDRAConfig names the DRA driver the claims of a vendor are written for. The resources of a vendor are only translated once its driver is set, other vendors keep their device plugins. Memory resources are requested from the driver in bytes, using the MemoryUnit of the vendor, and cores as they are.",
+	"VendorDRAConfig.DeviceClassName":          "DeviceClassName is the DeviceClass requested by the claims, defaults to the driver name.",
+	"VendorDRAConfig.DriverName":               "DriverName is the DRA driver publishing the devices of the vendor, translation is disabled if empty.",
+	"VendorDRAConfig.ProductNameAttribute":     "ProductNameAttribute is the device attribute holding the product or chip name, defaults to productName.",
+	"Watcher":                                  "Watcher reloads the device config file into a Store whenever the file changes. The parent directory is watched rather than the file itself, so the symlink swaps done by kubelet when updating ConfigMap volumes are noticed as well.",
+	"Watcher.Loaded":                           "Loaded is the file content the config in Store was parsed from, the file is reloaded once it differs.",
+	"Watcher.OnReload":                         "OnReload is called after every reload attempt, with the error if the new config was rejected.",
+	"Watcher.Strict":                           "Strict rejects configs with unknown fields.",
+}
@@ -19,9 +19,26 @@ package constants
 const (
 	UseUUIDAnnotation = "nvidia.com/use-gpuuuid"
 	UseTypeAnnotation = "nvidia.com/use-gputype"
+	// AMDUseTypeAnnotation pins the AMD GPUs of a pod to a product name, like UseTypeAnnotation does for NVIDIA.
+	AMDUseTypeAnnotation = "amd.com/use-gputype"
 
 	NvidiaDraDriver  = "hami-core-gpu.project-hami.io"
 	NvidiaDeviceType = "hami-gpu"
 
 	DraLabel = "hami.io/dra"
+	// SharedGPUGroupAnnotationPrefix declares a named group of containers sharing one GPU claim,
+	// e.g. "shared-gpu-group.hami.io/inference: server,profiler".
+	SharedGPUGroupAnnotationPrefix = "shared-gpu-group.hami.io/"
+	// SharedGPUClaimAnnotation names a ResourceClaim shared by every pod of the namespace carrying the same value.
+	// The webhook copies it to a label of the same key on the pod and on the claim.
+	SharedGPUClaimAnnotation = "hami.io/shared-gpu-claim"
+	SharedGPUClaimLabel      = SharedGPUClaimAnnotation
+	// SharedGPUClaimLastUsedAnnotation records on a shared ResourceClaim when a pod was last admitted with it.
+	SharedGPUClaimLastUsedAnnotation = "hami.io/shared-gpu-claim-last-used"
+	// PodNameAnnotation records on a generated ResourceClaim the name of the pod it was created for.
+	PodNameAnnotation = "hami.io/dra-pod-name"
+	// WorkloadAnnotation records on a generated ResourceClaimTemplate the kind and name of the workload it was created for.
+	WorkloadAnnotation = "hami.io/dra-workload"
+	// SkipTranslationAnnotation set to "true" on a pod or workload keeps its GPU resources untranslated.
+	SkipTranslationAnnotation = "hami.io/dra-skip"
 )
@@ -0,0 +1,168 @@
+/*
+Copyright 2025 The HAMi Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cleanup
+
+import (
+	"context"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	resourceapi "k8s.io/api/resource/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/klog/v2"
+	controllerruntime "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	"github.com/Project-HAMi/HAMi-DRA/pkg/constants"
+)
+
+// ControllerName is the controller name that will be used when reporting events and metrics.
+const ControllerName = "resourceclaim-cleanup"
+
+// DefaultGracePeriod is how long a generated ResourceClaim may exist without its pod.
+// The mutating webhook creates the claim before the pod is persisted, so a claim
+// younger than this is never considered orphaned.
+const DefaultGracePeriod = time.Minute
+
+// ResourceClaimCleanupController deletes ResourceClaims generated by the webhook whose pod no longer exists,
+// and shared ResourceClaims no pod uses anymore.
+type ResourceClaimCleanupController struct {
+	client.Client
+	GracePeriod time.Duration
+}
+
+// Reconcile performs a full reconciliation for the object referred to by the Request.
+// The Controller will requeue the Request to be processed again if an error is non-nil or
+// Result.Requeue is true, otherwise upon completion it will remove the work from the queue.
+func (c *ResourceClaimCleanupController) Reconcile(ctx context.Context, req controllerruntime.Request) (controllerruntime.Result, error) {
+	klog.V(4).Infof("Reconciling ResourceClaim %s", req.NamespacedName.String())
+
+	claim := &resourceapi.ResourceClaim{}
+	if err := c.Get(ctx, req.NamespacedName, claim); err != nil {
+		if apierrors.IsNotFound(err) {
+			return controllerruntime.Result{}, nil
+		}
+		return controllerruntime.Result{}, err
+	}
+	if !claim.DeletionTimestamp.IsZero() {
+		return controllerruntime.Result{}, nil
+	}
+
+	if name, ok := claim.Labels[constants.SharedGPUClaimLabel]; ok {
+		return c.reconcileSharedClaim(ctx, claim, name)
+	}
+
+	podName, ok := claim.Annotations[constants.PodNameAnnotation]
+	if !ok || podName == "" {
+		return controllerruntime.Result{}, nil
+	}
+	if len(claim.Status.ReservedFor) > 0 {
+		return controllerruntime.Result{}, nil
+	}
+
+	err := c.Get(ctx, types.NamespacedName{Namespace: claim.Namespace, Name: podName}, &corev1.Pod{})
+	if err == nil {
+		return controllerruntime.Result{}, nil
+	}
+	if !apierrors.IsNotFound(err) {
+		return controllerruntime.Result{}, err
+	}
+
+	if age := time.Since(claim.CreationTimestamp.Time); age < c.GracePeriod {
+		return controllerruntime.Result{RequeueAfter: c.GracePeriod - age}, nil
+	}
+
+	klog.Infof("Deleting orphaned ResourceClaim %s/%s of pod %s", claim.Namespace, claim.Name, podName)
+	if err := c.Delete(ctx, claim, client.Preconditions{UID: &claim.UID}); err != nil && !apierrors.IsNotFound(err) {
+		return controllerruntime.Result{}, err
+	}
+	return controllerruntime.Result{}, nil
+}
+
+// reconcileSharedClaim deletes a shared ResourceClaim once no pod of the namespace uses it anymore.
+func (c *ResourceClaimCleanupController) reconcileSharedClaim(ctx context.Context, claim *resourceapi.ResourceClaim, name string) (controllerruntime.Result, error) {
+	if len(claim.Status.ReservedFor) > 0 {
+		return controllerruntime.Result{}, nil
+	}
+
+	pods := &corev1.PodList{}
+	if err := c.List(ctx, pods, client.InNamespace(claim.Namespace), client.MatchingLabels{constants.SharedGPUClaimLabel: name}); err != nil {
+		return controllerruntime.Result{}, err
+	}
+	for _, pod := range pods.Items {
+		if pod.DeletionTimestamp.IsZero() {
+			return controllerruntime.Result{}, nil
+		}
+	}
+
+	lastUsed := claim.CreationTimestamp.Time
+	if t, err := time.Parse(time.RFC3339, claim.Annotations[constants.SharedGPUClaimLastUsedAnnotation]); err == nil && t.After(lastUsed) {
+		lastUsed = t
+	}
+	if idle := time.Since(lastUsed); idle < c.GracePeriod {
+		return controllerruntime.Result{RequeueAfter: c.GracePeriod - idle}, nil
+	}
+
+	klog.Infof("Deleting unused shared ResourceClaim %s/%s", claim.Namespace, claim.Name)
+	if err := c.Delete(ctx, claim, client.Preconditions{UID: &claim.UID, ResourceVersion: &claim.ResourceVersion}); err != nil && !apierrors.IsNotFound(err) && !apierrors.IsConflict(err) {
+		return controllerruntime.Result{}, err
+	}
+	return controllerruntime.Result{}, nil
+}
+
+// SetupWithManager creates a controller and register to controller manager.
+func (c *ResourceClaimCleanupController) SetupWithManager(mgr controllerruntime.Manager) error {
+	if c.GracePeriod == 0 {
+		c.GracePeriod = DefaultGracePeriod
+	}
+	generated := predicate.NewPredicateFuncs(func(obj client.Object) bool {
+		_, ok := obj.GetLabels()[constants.DraLabel]
+		return ok
+	})
+	return controllerruntime.NewControllerManagedBy(mgr).
+		Named(ControllerName).
+		For(&resourceapi.ResourceClaim{}, builder.WithPredicates(generated)).
+		Watches(&corev1.Pod{}, handler.EnqueueRequestsFromMapFunc(sharedClaimOfPod), builder.WithPredicates(sharedPodDeleted)).
+		Complete(c)
+}
+
+// sharedPodDeleted selects the deletions of pods using a shared claim.
+// The last user of a shared claim may go away before it was ever scheduled, leaving the claim untouched.
+var sharedPodDeleted = predicate.Funcs{
+	CreateFunc:  func(event.CreateEvent) bool { return false },
+	UpdateFunc:  func(event.UpdateEvent) bool { return false },
+	GenericFunc: func(event.GenericEvent) bool { return false },
+	DeleteFunc: func(e event.DeleteEvent) bool {
+		_, ok := e.Object.GetLabels()[constants.SharedGPUClaimLabel]
+		return ok
+	},
+}
+
+// sharedClaimOfPod maps a pod to the shared ResourceClaim it uses.
+func sharedClaimOfPod(_ context.Context, obj client.Object) []reconcile.Request {
+	name, ok := obj.GetLabels()[constants.SharedGPUClaimLabel]
+	if !ok {
+		return nil
+	}
+	return []reconcile.Request{{NamespacedName: types.NamespacedName{Namespace: obj.GetNamespace(), Name: name}}}
+}
@@ -0,0 +1,250 @@
+/*
+Copyright 2025 The HAMi Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cleanup
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	resourceapi "k8s.io/api/resource/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	controllerruntime "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+
+	"github.com/Project-HAMi/HAMi-DRA/pkg/constants"
+)
+
+// generatedClaim returns a ResourceClaim generated for the pod podName, created age ago.
+func generatedClaim(podName string, age time.Duration) *resourceapi.ResourceClaim {
+	return &resourceapi.ResourceClaim{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:              "default-" + podName + "-main",
+			Namespace:         "default",
+			Labels:            map[string]string{constants.DraLabel: "true"},
+			Annotations:       map[string]string{constants.PodNameAnnotation: podName},
+			CreationTimestamp: metav1.NewTime(time.Now().Add(-age)),
+		},
+	}
+}
+
+// sharedClaim returns the shared ResourceClaim named name, created age ago.
+func sharedClaim(name string, age time.Duration) *resourceapi.ResourceClaim {
+	return &resourceapi.ResourceClaim{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:              name,
+			Namespace:         "default",
+			Labels:            map[string]string{constants.DraLabel: "true", constants.SharedGPUClaimLabel: name},
+			CreationTimestamp: metav1.NewTime(time.Now().Add(-age)),
+		},
+	}
+}
+
+// sharedPod returns a pod using the shared ResourceClaim named claimName.
+func sharedPod(name, claimName string) *corev1.Pod {
+	return &corev1.Pod{ObjectMeta: metav1.ObjectMeta{
+		Name:      name,
+		Namespace: "default",
+		Labels:    map[string]string{constants.SharedGPUClaimLabel: claimName},
+	}}
+}
+
+// deleting marks the object as being deleted, the fake client only keeps such objects with a finalizer.
+func deleting[T client.Object](obj T) T {
+	now := metav1.Now()
+	obj.SetDeletionTimestamp(&now)
+	obj.SetFinalizers([]string{"test.project-hami.io/finalizer"})
+	return obj
+}
+
+// reserved marks the claim as reserved for a pod.
+func reserved(claim *resourceapi.ResourceClaim) *resourceapi.ResourceClaim {
+	claim.Status.ReservedFor = []resourceapi.ResourceClaimConsumerReference{{Resource: "pods", Name: "user", UID: "uid"}}
+	return claim
+}
+
+func TestReconcile(t *testing.T) {
+	tests := []struct {
+		Name          string
+		Claim         *resourceapi.ResourceClaim
+		Objects       []client.Object
+		ExpectDeleted bool
+		ExpectRequeue bool
+	}{
+		{
+			Name:          "orphaned claim",
+			Claim:         generatedClaim("gone", 2*time.Minute),
+			ExpectDeleted: true,
+		},
+		{
+			Name:          "orphaned claim within the grace period",
+			Claim:         generatedClaim("pending", 10*time.Second),
+			ExpectRequeue: true,
+		},
+		{
+			Name:    "claim of an existing pod",
+			Claim:   generatedClaim("running", 2*time.Minute),
+			Objects: []client.Object{&corev1.Pod{ObjectMeta: metav1.ObjectMeta{Name: "running", Namespace: "default"}}},
+		},
+		{
+			Name:  "reserved claim",
+			Claim: reserved(generatedClaim("gone", 2*time.Minute)),
+		},
+		{
+			Name: "claim without pod annotation",
+			Claim: &resourceapi.ResourceClaim{ObjectMeta: metav1.ObjectMeta{
+				Name:              "manual",
+				Namespace:         "default",
+				Labels:            map[string]string{constants.DraLabel: "true"},
+				CreationTimestamp: metav1.NewTime(time.Now().Add(-time.Hour)),
+			}},
+		},
+		{
+			Name:  "claim being deleted",
+			Claim: deleting(generatedClaim("gone", 2*time.Minute)),
+		},
+		{
+			Name:          "unused shared claim",
+			Claim:         sharedClaim("loader", 2*time.Minute),
+			ExpectDeleted: true,
+		},
+		{
+			Name:    "shared claim in use",
+			Claim:   sharedClaim("loader", 2*time.Minute),
+			Objects: []client.Object{sharedPod("trainer", "loader")},
+		},
+		{
+			Name:          "shared claim of a deleting pod",
+			Claim:         sharedClaim("loader", 2*time.Minute),
+			Objects:       []client.Object{deleting(sharedPod("trainer", "loader"))},
+			ExpectDeleted: true,
+		},
+		{
+			Name:    "shared claim of a pod using another claim",
+			Claim:   sharedClaim("loader", 2*time.Minute),
+			Objects: []client.Object{sharedPod("trainer", "other")},
+			// The pod of the other claim does not keep this one.
+			ExpectDeleted: true,
+		},
+		{
+			Name: "recently used shared claim",
+			Claim: func() *resourceapi.ResourceClaim {
+				claim := sharedClaim("loader", time.Hour)
+				claim.Annotations = map[string]string{constants.SharedGPUClaimLastUsedAnnotation: time.Now().Add(-10 * time.Second).Format(time.RFC3339)}
+				return claim
+			}(),
+			ExpectRequeue: true,
+		},
+		{
+			Name:  "reserved shared claim",
+			Claim: reserved(sharedClaim("loader", 2*time.Minute)),
+		},
+	}
+
+	for i := range tests {
+		tc := tests[i]
+
+		t.Run(tc.Name, func(t *testing.T) {
+			deletes := 0
+			cl := fake.NewClientBuilder().WithObjects(append(tc.Objects, tc.Claim)...).WithInterceptorFuncs(interceptor.Funcs{
+				Delete: func(ctx context.Context, cl client.WithWatch, obj client.Object, opts ...client.DeleteOption) error {
+					deletes++
+					return cl.Delete(ctx, obj, opts...)
+				},
+			}).Build()
+			c := &ResourceClaimCleanupController{Client: cl, GracePeriod: DefaultGracePeriod}
+			key := client.ObjectKeyFromObject(tc.Claim)
+
+			result, err := c.Reconcile(context.TODO(), controllerruntime.Request{NamespacedName: key})
+			if err != nil {
+				t.Fatalf("No error is expected but got: %v", err)
+			}
+			if requeue := result.RequeueAfter > 0; requeue != tc.ExpectRequeue {
+				t.Fatalf("expect requeue: %v, but got: %v", tc.ExpectRequeue, result.RequeueAfter)
+			}
+			if tc.ExpectRequeue && result.RequeueAfter > DefaultGracePeriod {
+				t.Fatalf("expect a requeue within the grace period, but got: %v", result.RequeueAfter)
+			}
+			err = cl.Get(context.TODO(), key, &resourceapi.ResourceClaim{})
+			if deleted := apierrors.IsNotFound(err); deleted != tc.ExpectDeleted {
+				t.Fatalf("expect deleted: %v, but got: %v", tc.ExpectDeleted, err)
+			}
+			if !tc.ExpectDeleted && deletes != 0 {
+				t.Fatalf("expect the claim to be kept, but it was deleted %d times", deletes)
+			}
+		})
+	}
+}
+
+func TestReconcileNotFound(t *testing.T) {
+	c := &ResourceClaimCleanupController{Client: fake.NewClientBuilder().Build(), GracePeriod: DefaultGracePeriod}
+	result, err := c.Reconcile(context.TODO(), controllerruntime.Request{NamespacedName: types.NamespacedName{Namespace: "default", Name: "missing"}})
+	if err != nil {
+		t.Fatalf("No error is expected but got: %v", err)
+	}
+	if result.RequeueAfter != 0 {
+		t.Fatalf("expect no requeue, but got: %v", result.RequeueAfter)
+	}
+}
+
+func TestSharedPodWatch(t *testing.T) {
+	tests := []struct {
+		Name          string
+		Pod           *corev1.Pod
+		ExpectEnqueue bool
+	}{
+		{
+			Name:          "pod of a shared claim",
+			Pod:           sharedPod("trainer", "loader"),
+			ExpectEnqueue: true,
+		},
+		{
+			Name: "pod without shared claim",
+			Pod:  &corev1.Pod{ObjectMeta: metav1.ObjectMeta{Name: "web", Namespace: "default"}},
+		},
+	}
+
+	for i := range tests {
+		tc := tests[i]
+
+		t.Run(tc.Name, func(t *testing.T) {
+			if sharedPodDeleted.Create(event.CreateEvent{Object: tc.Pod}) || sharedPodDeleted.Update(event.UpdateEvent{ObjectOld: tc.Pod, ObjectNew: tc.Pod}) {
+				t.Fatalf("expect only pod deletions to be watched")
+			}
+			if deleted := sharedPodDeleted.Delete(event.DeleteEvent{Object: tc.Pod}); deleted != tc.ExpectEnqueue {
+				t.Fatalf("expect deletion watched: %v, but got: %v", tc.ExpectEnqueue, deleted)
+			}
+			requests := sharedClaimOfPod(context.TODO(), tc.Pod)
+			if !tc.ExpectEnqueue {
+				if len(requests) != 0 {
+					t.Fatalf("expect no request, but got: %v", requests)
+				}
+				return
+			}
+			expect := types.NamespacedName{Namespace: "default", Name: "loader"}
+			if len(requests) != 1 || requests[0].NamespacedName != expect {
+				t.Fatalf("expect request for %s, but got: %v", expect, requests)
+			}
+		})
+	}
+}
@@ -0,0 +1,180 @@
+/*
+Copyright 2025 The HAMi Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cleanup
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	appsv1 "k8s.io/api/apps/v1"
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	resourceapi "k8s.io/api/resource/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/klog/v2"
+	controllerruntime "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+
+	"github.com/Project-HAMi/HAMi-DRA/pkg/constants"
+)
+
+// TemplateControllerName is the controller name that will be used when reporting events and metrics.
+const TemplateControllerName = "resourceclaimtemplate-cleanup"
+
+// ResourceClaimTemplateCleanupController makes the workloads own the ResourceClaimTemplates generated for them,
+// so that the templates are garbage collected with their workload.
+// The mutating webhook can only set the owner when a workload is updated, a workload being created has no UID yet,
+// and no name either if it uses generateName. The owner is therefore the workload whose pod template uses the template.
+// Templates no workload uses, such as when the workload creation was rejected by a later admission webhook, are deleted.
+type ResourceClaimTemplateCleanupController struct {
+	client.Client
+	// APIReader lists the workloads, which are not cached.
+	APIReader   client.Reader
+	GracePeriod time.Duration
+}
+
+// Reconcile performs a full reconciliation for the object referred to by the Request.
+// The Controller will requeue the Request to be processed again if an error is non-nil or
+// Result.Requeue is true, otherwise upon completion it will remove the work from the queue.
+func (c *ResourceClaimTemplateCleanupController) Reconcile(ctx context.Context, req controllerruntime.Request) (controllerruntime.Result, error) {
+	klog.V(4).Infof("Reconciling ResourceClaimTemplate %s", req.NamespacedName.String())
+
+	rct := &resourceapi.ResourceClaimTemplate{}
+	if err := c.Get(ctx, req.NamespacedName, rct); err != nil {
+		if apierrors.IsNotFound(err) {
+			return controllerruntime.Result{}, nil
+		}
+		return controllerruntime.Result{}, err
+	}
+	if !rct.DeletionTimestamp.IsZero() || len(rct.OwnerReferences) > 0 {
+		return controllerruntime.Result{}, nil
+	}
+
+	kind, name, ok := strings.Cut(rct.Annotations[constants.WorkloadAnnotation], "/")
+	if !ok {
+		return controllerruntime.Result{}, nil
+	}
+	list, gvk := newWorkloadList(kind)
+	if list == nil {
+		return controllerruntime.Result{}, nil
+	}
+	if err := c.APIReader.List(ctx, list, client.InNamespace(rct.Namespace)); err != nil {
+		return controllerruntime.Result{}, err
+	}
+	workload, err := workloadUsing(list, rct.Name, name)
+	if err != nil {
+		return controllerruntime.Result{}, err
+	}
+
+	if workload == nil {
+		if age := time.Since(rct.CreationTimestamp.Time); age < c.GracePeriod {
+			return controllerruntime.Result{RequeueAfter: c.GracePeriod - age}, nil
+		}
+		klog.Infof("Deleting ResourceClaimTemplate %s/%s used by no %s", rct.Namespace, rct.Name, kind)
+		if err := c.Delete(ctx, rct, client.Preconditions{UID: &rct.UID}); err != nil && !apierrors.IsNotFound(err) {
+			return controllerruntime.Result{}, err
+		}
+		return controllerruntime.Result{}, nil
+	}
+
+	klog.V(4).Infof("Adopting ResourceClaimTemplate %s/%s by %s %s", rct.Namespace, rct.Name, kind, workload.GetName())
+	rct.OwnerReferences = []metav1.OwnerReference{{
+		APIVersion: gvk.GroupVersion().String(),
+		Kind:       gvk.Kind,
+		Name:       workload.GetName(),
+		UID:        workload.GetUID(),
+	}}
+	return controllerruntime.Result{}, c.Update(ctx, rct)
+}
+
+// newWorkloadList returns an empty list of the workload kind translated by the webhook, or nil for other kinds.
+func newWorkloadList(kind string) (client.ObjectList, schema.GroupVersionKind) {
+	switch kind {
+	case "Deployment":
+		return &appsv1.DeploymentList{}, appsv1.SchemeGroupVersion.WithKind(kind)
+	case "StatefulSet":
+		return &appsv1.StatefulSetList{}, appsv1.SchemeGroupVersion.WithKind(kind)
+	case "DaemonSet":
+		return &appsv1.DaemonSetList{}, appsv1.SchemeGroupVersion.WithKind(kind)
+	case "Job":
+		return &batchv1.JobList{}, batchv1.SchemeGroupVersion.WithKind(kind)
+	case "CronJob":
+		return &batchv1.CronJobList{}, batchv1.SchemeGroupVersion.WithKind(kind)
+	}
+	return nil, schema.GroupVersionKind{}
+}
+
+// workloadUsing returns the workload of the list whose pod template uses the named ResourceClaimTemplate.
+// If none does, such as when the workload was updated before the template was adopted, the workload named
+// workloadName is returned, as the ReplicaSets or pods it still has may use the template.
+func workloadUsing(list client.ObjectList, rctName, workloadName string) (client.Object, error) {
+	items, err := meta.ExtractList(list)
+	if err != nil {
+		return nil, err
+	}
+	var named client.Object
+	for _, item := range items {
+		if obj := item.(client.Object); obj.GetName() == workloadName {
+			named = obj
+		}
+		var podSpec *corev1.PodSpec
+		switch workload := item.(type) {
+		case *appsv1.Deployment:
+			podSpec = &workload.Spec.Template.Spec
+		case *appsv1.StatefulSet:
+			podSpec = &workload.Spec.Template.Spec
+		case *appsv1.DaemonSet:
+			podSpec = &workload.Spec.Template.Spec
+		case *batchv1.Job:
+			podSpec = &workload.Spec.Template.Spec
+		case *batchv1.CronJob:
+			podSpec = &workload.Spec.JobTemplate.Spec.Template.Spec
+		default:
+			continue
+		}
+		for _, claim := range podSpec.ResourceClaims {
+			if claim.ResourceClaimTemplateName != nil && *claim.ResourceClaimTemplateName == rctName {
+				return item.(client.Object), nil
+			}
+		}
+	}
+	return named, nil
+}
+
+// SetupWithManager creates a controller and register to controller manager.
+func (c *ResourceClaimTemplateCleanupController) SetupWithManager(mgr controllerruntime.Manager) error {
+	if c.GracePeriod == 0 {
+		c.GracePeriod = DefaultGracePeriod
+	}
+	if c.APIReader == nil {
+		c.APIReader = mgr.GetAPIReader()
+	}
+	generated := predicate.NewPredicateFuncs(func(obj client.Object) bool {
+		_, ok := obj.GetAnnotations()[constants.WorkloadAnnotation]
+		return ok
+	})
+	return controllerruntime.NewControllerManagedBy(mgr).
+		Named(TemplateControllerName).
+		For(&resourceapi.ResourceClaimTemplate{}, builder.WithPredicates(generated)).
+		Complete(c)
+}
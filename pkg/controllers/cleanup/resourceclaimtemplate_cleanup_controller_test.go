@@ -0,0 +1,170 @@
+/*
+Copyright 2025 The HAMi Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cleanup
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	appsv1 "k8s.io/api/apps/v1"
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	resourceapi "k8s.io/api/resource/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	controllerruntime "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"github.com/Project-HAMi/HAMi-DRA/pkg/constants"
+)
+
+// workloadTemplate returns a ResourceClaimTemplate generated for the workload, created age ago.
+func workloadTemplate(workload string, age time.Duration) *resourceapi.ResourceClaimTemplate {
+	return &resourceapi.ResourceClaimTemplate{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:              "web-main-abc",
+			Namespace:         "default",
+			Labels:            map[string]string{constants.DraLabel: "true"},
+			Annotations:       map[string]string{constants.WorkloadAnnotation: workload},
+			CreationTimestamp: metav1.NewTime(time.Now().Add(-age)),
+		},
+	}
+}
+
+// usingTemplate returns a pod template spec using the named ResourceClaimTemplate.
+func usingTemplate(rctName string) corev1.PodTemplateSpec {
+	return corev1.PodTemplateSpec{Spec: corev1.PodSpec{
+		ResourceClaims: []corev1.PodResourceClaim{{Name: "main", ResourceClaimTemplateName: &rctName}},
+	}}
+}
+
+func TestReconcileTemplate(t *testing.T) {
+	deployment := &appsv1.Deployment{
+		ObjectMeta: metav1.ObjectMeta{Name: "web", Namespace: "default", UID: "deployment-uid"},
+		Spec:       appsv1.DeploymentSpec{Template: usingTemplate("web-main-abc")},
+	}
+	// The workload was created with generateName, the template is annotated with the prefix only.
+	job := &batchv1.Job{
+		ObjectMeta: metav1.ObjectMeta{Name: "web-x7k2p", GenerateName: "web-", Namespace: "default", UID: "job-uid"},
+		Spec:       batchv1.JobSpec{Template: usingTemplate("web-main-abc")},
+	}
+	cronJob := &batchv1.CronJob{ObjectMeta: metav1.ObjectMeta{Name: "web", Namespace: "default", UID: "cronjob-uid"}}
+	cronJob.Spec.JobTemplate.Spec.Template = usingTemplate("web-main-abc")
+	// The workload the template was generated for, no longer using it after its resources changed.
+	updated := &appsv1.Deployment{
+		ObjectMeta: metav1.ObjectMeta{Name: "web", Namespace: "default", UID: "deployment-uid"},
+		Spec:       appsv1.DeploymentSpec{Template: usingTemplate("web-main-def")},
+	}
+	owned := workloadTemplate("Deployment/web", time.Hour)
+	owned.OwnerReferences = []metav1.OwnerReference{{APIVersion: "apps/v1", Kind: "Deployment", Name: "web", UID: "other-uid"}}
+
+	tests := []struct {
+		Name          string
+		Template      *resourceapi.ResourceClaimTemplate
+		Objects       []client.Object
+		ExpectOwner   string
+		ExpectDeleted bool
+		ExpectRequeue bool
+	}{
+		{
+			Name:        "adopted by its workload",
+			Template:    workloadTemplate("Deployment/web", time.Hour),
+			Objects:     []client.Object{deployment},
+			ExpectOwner: "deployment-uid",
+		},
+		{
+			Name:        "adopted by a workload with a generated name",
+			Template:    workloadTemplate("Job/web", time.Hour),
+			Objects:     []client.Object{job},
+			ExpectOwner: "job-uid",
+		},
+		{
+			Name:        "adopted by a cronjob",
+			Template:    workloadTemplate("CronJob/web", time.Hour),
+			Objects:     []client.Object{cronJob},
+			ExpectOwner: "cronjob-uid",
+		},
+		{
+			Name:        "named workload no longer using it",
+			Template:    workloadTemplate("Deployment/web", time.Hour),
+			Objects:     []client.Object{updated},
+			ExpectOwner: "deployment-uid",
+		},
+		{
+			Name:          "workload of another kind",
+			Template:      workloadTemplate("StatefulSet/web", time.Hour),
+			Objects:       []client.Object{deployment},
+			ExpectDeleted: true,
+		},
+		{
+			Name:     "already owned",
+			Template: owned,
+			Objects:  []client.Object{deployment},
+			// The owner set by the webhook is kept.
+			ExpectOwner: "other-uid",
+		},
+		{
+			Name:          "workload never created",
+			Template:      workloadTemplate("Deployment/web", 2*time.Minute),
+			ExpectDeleted: true,
+		},
+		{
+			Name:          "workload being created",
+			Template:      workloadTemplate("Deployment/web", 10*time.Second),
+			ExpectRequeue: true,
+		},
+		{
+			Name:     "unknown workload kind",
+			Template: workloadTemplate("ReplicaSet/web", time.Hour),
+		},
+	}
+
+	for i := range tests {
+		tc := tests[i]
+
+		t.Run(tc.Name, func(t *testing.T) {
+			cl := fake.NewClientBuilder().WithObjects(append(tc.Objects, tc.Template)...).Build()
+			c := &ResourceClaimTemplateCleanupController{Client: cl, APIReader: cl, GracePeriod: DefaultGracePeriod}
+			key := client.ObjectKeyFromObject(tc.Template)
+
+			result, err := c.Reconcile(context.TODO(), controllerruntime.Request{NamespacedName: key})
+			if err != nil {
+				t.Fatalf("No error is expected but got: %v", err)
+			}
+			if requeue := result.RequeueAfter > 0; requeue != tc.ExpectRequeue {
+				t.Fatalf("expect requeue: %v, but got: %v", tc.ExpectRequeue, result.RequeueAfter)
+			}
+			rct := &resourceapi.ResourceClaimTemplate{}
+			err = cl.Get(context.TODO(), key, rct)
+			if deleted := apierrors.IsNotFound(err); deleted != tc.ExpectDeleted {
+				t.Fatalf("expect deleted: %v, but got: %v", tc.ExpectDeleted, err)
+			}
+			if tc.ExpectDeleted {
+				return
+			}
+			var owner string
+			if len(rct.OwnerReferences) > 0 {
+				owner = string(rct.OwnerReferences[0].UID)
+			}
+			if owner != tc.ExpectOwner {
+				t.Fatalf("expect owner: %q, but got: %v", tc.ExpectOwner, rct.OwnerReferences)
+			}
+		})
+	}
+}
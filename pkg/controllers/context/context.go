@@ -0,0 +1,111 @@
+/*
+Copyright 2025 The HAMi Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package context
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/klog/v2"
+	controllerruntime "sigs.k8s.io/controller-runtime"
+
+	"github.com/Project-HAMi/HAMi-DRA/pkg/config"
+)
+
+// Context defines the context object for controllers.
+type Context struct {
+	Mgr         controllerruntime.Manager
+	Context     context.Context
+	ConfigStore *config.Store
+}
+
+// InitFunc is used to launch a particular controller.
+// Any error returned will cause the webhook process to `Fatal`.
+// The bool indicates whether the controller was enabled.
+type InitFunc func(ctx Context) (enabled bool, err error)
+
+// Initializers is a public map of named controller groups.
+type Initializers map[string]InitFunc
+
+// ControllerNames returns all known controller names.
+func (i Initializers) ControllerNames() []string {
+	names := make([]string, 0, len(i))
+	for name := range i {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// Validate checks that every name in controllers, ignoring "*" and the "-" prefix, is a known controller.
+func (i Initializers) Validate(controllers []string) error {
+	known := sets.New(i.ControllerNames()...)
+	for _, name := range controllers {
+		if name == "*" {
+			continue
+		}
+		if len(name) > 0 && name[0] == '-' {
+			name = name[1:]
+		}
+		if !known.Has(name) {
+			return fmt.Errorf("%q is not in the list of known controllers: %v", name, i.ControllerNames())
+		}
+	}
+	return nil
+}
+
+// StartControllers starts a set of controllers with a specified ControllerContext.
+func (i Initializers) StartControllers(ctx Context, controllers []string) error {
+	for _, name := range i.ControllerNames() {
+		if !IsControllerEnabled(name, controllers) {
+			klog.Warningf("%q is disabled", name)
+			continue
+		}
+		klog.V(1).Infof("Starting %q", name)
+		started, err := i[name](ctx)
+		if err != nil {
+			klog.Errorf("Error starting %q", name)
+			return err
+		}
+		if !started {
+			klog.Warningf("Skipping %q", name)
+			continue
+		}
+		klog.Infof("Started %q", name)
+	}
+	return nil
+}
+
+// IsControllerEnabled checks if a specified controller enabled or not.
+// A controller is enabled if its name is listed, or if "*" is listed and "-name" is not.
+func IsControllerEnabled(name string, controllers []string) bool {
+	hasStar := false
+	for _, ctrl := range controllers {
+		if ctrl == name {
+			return true
+		}
+		if ctrl == "-"+name {
+			return false
+		}
+		if ctrl == "*" {
+			hasStar = true
+		}
+	}
+	return hasStar
+}
@@ -0,0 +1,126 @@
+/*
+Copyright 2025 The HAMi Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package context
+
+import (
+	"testing"
+)
+
+func TestIsControllerEnabled(t *testing.T) {
+	tests := []struct {
+		Name        string
+		Controllers []string
+		Expect      bool
+	}{
+		{
+			Name:        "all controllers",
+			Controllers: []string{"*"},
+			Expect:      true,
+		},
+		{
+			Name:        "listed",
+			Controllers: []string{"cleanup"},
+			Expect:      true,
+		},
+		{
+			Name:        "not listed",
+			Controllers: []string{"adoption"},
+			Expect:      false,
+		},
+		{
+			Name:        "disabled despite star",
+			Controllers: []string{"*", "-cleanup"},
+			Expect:      false,
+		},
+		{
+			Name:        "none",
+			Controllers: nil,
+			Expect:      false,
+		},
+	}
+
+	for i := range tests {
+		tc := tests[i]
+
+		t.Run(tc.Name, func(t *testing.T) {
+			if enabled := IsControllerEnabled("cleanup", tc.Controllers); enabled != tc.Expect {
+				t.Fatalf("expect enabled: %v, but got: %v", tc.Expect, enabled)
+			}
+		})
+	}
+}
+
+func TestValidate(t *testing.T) {
+	initializers := Initializers{
+		"cleanup":  func(Context) (bool, error) { return true, nil },
+		"adoption": func(Context) (bool, error) { return true, nil },
+	}
+	tests := []struct {
+		Name        string
+		Controllers []string
+		ExpectError bool
+	}{
+		{
+			Name:        "star and known names",
+			Controllers: []string{"*", "-adoption", "cleanup"},
+		},
+		{
+			Name:        "unknown controller",
+			Controllers: []string{"backfill"},
+			ExpectError: true,
+		},
+		{
+			Name:        "unknown disabled controller",
+			Controllers: []string{"*", "-backfill"},
+			ExpectError: true,
+		},
+	}
+
+	for i := range tests {
+		tc := tests[i]
+
+		t.Run(tc.Name, func(t *testing.T) {
+			err := initializers.Validate(tc.Controllers)
+			if tc.ExpectError && err == nil {
+				t.Fatal("Expect error, but got nil")
+			}
+			if !tc.ExpectError && err != nil {
+				t.Fatalf("No error is expected but got: %v", err)
+			}
+		})
+	}
+}
+
+func TestStartControllers(t *testing.T) {
+	var started []string
+	initializers := Initializers{
+		"cleanup": func(Context) (bool, error) {
+			started = append(started, "cleanup")
+			return true, nil
+		},
+		"adoption": func(Context) (bool, error) {
+			started = append(started, "adoption")
+			return true, nil
+		},
+	}
+	if err := initializers.StartControllers(Context{}, []string{"*", "-adoption"}); err != nil {
+		t.Fatalf("No error is expected but got: %v", err)
+	}
+	if len(started) != 1 || started[0] != "cleanup" {
+		t.Fatalf("expect only cleanup to be started, but got: %v", started)
+	}
+}
@@ -0,0 +1,97 @@
+/*
+Copyright 2025 The HAMi Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package amd translates the AMD GPU resource into DRA device requests.
+package amd
+
+import (
+	"fmt"
+
+	corev1 "k8s.io/api/core/v1"
+	resourceapi "k8s.io/api/resource/v1"
+
+	"github.com/Project-HAMi/HAMi-DRA/pkg/config"
+	"github.com/Project-HAMi/HAMi-DRA/pkg/constants"
+	"github.com/Project-HAMi/HAMi-DRA/pkg/device"
+)
+
+const (
+	// Name is the name of the AMD translator.
+	Name = "amd"
+
+	// requestName is the name of the request for AMD GPUs.
+	requestName = "amdgpu"
+)
+
+func init() {
+	device.Register(Name, func(deviceConfig *config.Config) device.DeviceTranslator {
+		if translator := NewTranslator(&deviceConfig.AMD); translator != nil {
+			return translator
+		}
+		return nil
+	})
+}
+
+// Translator translates AMD GPUs into exact counts of whole GPUs of the AMD DeviceClass.
+type Translator struct {
+	config *config.AMDConfig
+}
+
+// Check if our Translator implements necessary interface
+var _ device.DeviceTranslator = &Translator{}
+
+// NewTranslator creates a translator for the AMD section of the device config, or returns nil if its DRA driver is not configured.
+func NewTranslator(amdConfig *config.AMDConfig) *Translator {
+	if !amdConfig.DRA.Enabled() || amdConfig.ResourceCountName == "" {
+		return nil
+	}
+	return &Translator{config: amdConfig}
+}
+
+// Name identifies the translator in logs and errors.
+func (t *Translator) Name() string {
+	return Name
+}
+
+// ResourceNames returns the GPU resource of AMD.
+func (t *Translator) ResourceNames() []corev1.ResourceName {
+	return []corev1.ResourceName{corev1.ResourceName(t.config.ResourceCountName)}
+}
+
+// Translate removes the AMD GPUs from the container and returns the equivalent device request.
+// The amd.com/use-gputype annotation of the pod selects the product name of the GPUs.
+func (t *Translator) Translate(container *corev1.Container, pod *device.Pod) (*resourceapi.DeviceClaim, error) {
+	countName := corev1.ResourceName(t.config.ResourceCountName)
+	countQty, ok := container.Resources.Limits[countName]
+	if !ok {
+		return nil, nil
+	}
+
+	exactly := &resourceapi.ExactDeviceRequest{
+		DeviceClassName: t.config.DRA.DeviceClassName,
+		AllocationMode:  resourceapi.DeviceAllocationModeExactCount,
+		Count:           countQty.Value(),
+	}
+	if productName, ok := pod.Annotations[constants.AMDUseTypeAnnotation]; ok {
+		exactly.Selectors = append(exactly.Selectors,
+			device.CELSelector(fmt.Sprintf(`%s == %s`, device.Attribute(t.config.DRA.DriverName, t.config.DRA.ProductNameAttribute), device.CELString(productName))))
+	}
+	device.RemoveResource(container, countName)
+
+	return &resourceapi.DeviceClaim{
+		Requests: []resourceapi.DeviceRequest{{Name: requestName, Exactly: exactly}},
+	}, nil
+}
@@ -0,0 +1,111 @@
+/*
+Copyright 2025 The HAMi Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package amd
+
+import (
+	"testing"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+
+	"github.com/Project-HAMi/HAMi-DRA/pkg/config"
+	"github.com/Project-HAMi/HAMi-DRA/pkg/constants"
+	"github.com/Project-HAMi/HAMi-DRA/pkg/device"
+)
+
+func TestTranslate(t *testing.T) {
+	tests := []struct {
+		Name           string
+		Annotations    map[string]string
+		Limits         corev1.ResourceList
+		ExpectClaim    bool
+		ExpectCount    int64
+		ExpectSelector string
+	}{
+		{
+			Name:        "whole gpus",
+			Limits:      corev1.ResourceList{"amd.com/gpu": resource.MustParse("2")},
+			ExpectClaim: true,
+			ExpectCount: 2,
+		},
+		{
+			Name:           "gpu type annotation",
+			Annotations:    map[string]string{constants.AMDUseTypeAnnotation: "MI300X"},
+			Limits:         corev1.ResourceList{"amd.com/gpu": resource.MustParse("1")},
+			ExpectClaim:    true,
+			ExpectCount:    1,
+			ExpectSelector: `device.attributes["gpu.amd.com"].productName == "MI300X"`,
+		},
+		{
+			Name:           "gpu type annotation with quotes",
+			Annotations:    map[string]string{constants.AMDUseTypeAnnotation: `MI300X" || true || "`},
+			Limits:         corev1.ResourceList{"amd.com/gpu": resource.MustParse("1")},
+			ExpectClaim:    true,
+			ExpectCount:    1,
+			ExpectSelector: `device.attributes["gpu.amd.com"].productName == "MI300X\" || true || \""`,
+		},
+		{
+			Name:   "no amd gpus",
+			Limits: corev1.ResourceList{"nvidia.com/gpu": resource.MustParse("1")},
+		},
+	}
+
+	deviceConfig := &config.Config{AMD: config.AMDConfig{
+		ResourceCountName: "amd.com/gpu",
+		DRA:               config.VendorDRAConfig{DriverName: "gpu.amd.com"},
+	}}
+	config.SetDefaults(deviceConfig)
+	translator := NewTranslator(&deviceConfig.AMD)
+	for i := range tests {
+		tc := tests[i]
+
+		t.Run(tc.Name, func(t *testing.T) {
+			container := &corev1.Container{Name: "main", Resources: corev1.ResourceRequirements{Limits: tc.Limits}}
+
+			claim, err := translator.Translate(container, &device.Pod{Annotations: tc.Annotations})
+			if err != nil {
+				t.Fatalf("No error is expected but got: %v", err)
+			}
+			if !tc.ExpectClaim {
+				if claim != nil {
+					t.Fatalf("expect no claim, but got: %v", claim)
+				}
+				return
+			}
+			request := claim.Requests[0]
+			if request.Exactly.DeviceClassName != "gpu.amd.com" || request.Exactly.Count != tc.ExpectCount {
+				t.Fatalf("expect %d devices of gpu.amd.com, but got: %d devices of %s", tc.ExpectCount, request.Exactly.Count, request.Exactly.DeviceClassName)
+			}
+			if tc.ExpectSelector == "" {
+				if len(request.Exactly.Selectors) != 0 {
+					t.Fatalf("expect no selector, but got: %v", request.Exactly.Selectors)
+				}
+			} else if selectors := request.Exactly.Selectors; len(selectors) != 1 || selectors[0].CEL.Expression != tc.ExpectSelector {
+				t.Fatalf("expect selector: %s, but got: %v", tc.ExpectSelector, selectors)
+			}
+			if _, ok := container.Resources.Limits["amd.com/gpu"]; ok {
+				t.Fatalf("expect amd.com/gpu to be removed, but got: %v", container.Resources.Limits)
+			}
+		})
+	}
+}
+
+func TestNewTranslatorDisabled(t *testing.T) {
+	if translator := NewTranslator(&config.AMDConfig{ResourceCountName: "amd.com/gpu"}); translator != nil {
+		t.Fatalf("expect no translator without a DRA driver, but got: %v", translator)
+	}
+}
@@ -0,0 +1,181 @@
+/*
+Copyright 2025 The HAMi Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package ascend translates the Huawei Ascend NPU and vNPU resources into DRA device requests.
+package ascend
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+	resourceapi "k8s.io/api/resource/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	"github.com/Project-HAMi/HAMi-DRA/pkg/config"
+	"github.com/Project-HAMi/HAMi-DRA/pkg/device"
+)
+
+const (
+	// Name is the name of the Ascend translator.
+	Name = "ascend"
+
+	// ParametersAPIVersion and VNPUParametersKind identify the opaque parameters of a vNPU request.
+	ParametersAPIVersion = "resource.project-hami.io/v1alpha1"
+	VNPUParametersKind   = "VNPUConfig"
+)
+
+func init() {
+	device.Register(Name, func(deviceConfig *config.Config) device.DeviceTranslator {
+		if translator := NewTranslator(deviceConfig.VNPUs); translator != nil {
+			return translator
+		}
+		return nil
+	})
+}
+
+// VNPUParameters are the opaque parameters of a vNPU request, naming the virtualization template the driver creates.
+type VNPUParameters struct {
+	metav1.TypeMeta `json:",inline"`
+	// Template is the name of the vNPU template, such as vir05_1c_16g.
+	Template string `json:"template"`
+}
+
+// Translator translates the resources of the Ascend chips whose DRA driver is configured.
+type Translator struct {
+	chips []config.VNPUConfig
+}
+
+// Check if our Translator implements necessary interface
+var _ device.DeviceTranslator = &Translator{}
+
+// NewTranslator creates a translator for the chips whose DRA driver is configured, or returns nil if there is none.
+func NewTranslator(vnpus []config.VNPUConfig) *Translator {
+	var chips []config.VNPUConfig
+	for _, vnpu := range vnpus {
+		if vnpu.DRA.Enabled() {
+			chips = append(chips, vnpu)
+		}
+	}
+	if len(chips) == 0 {
+		return nil
+	}
+	return &Translator{chips: chips}
+}
+
+// Name identifies the translator in logs and errors.
+func (t *Translator) Name() string {
+	return Name
+}
+
+// ResourceNames returns the NPU and memory resources of the translated chips.
+func (t *Translator) ResourceNames() []corev1.ResourceName {
+	var names []corev1.ResourceName
+	for _, chip := range t.chips {
+		names = append(names, corev1.ResourceName(chip.ResourceName), corev1.ResourceName(chip.ResourceMemoryName))
+	}
+	return names
+}
+
+// Translate removes the NPU resources of every chip from the container and returns one request per chip.
+// NPUs are requested whole unless the memory fits a vNPU template, the smallest such template is passed to the driver.
+func (t *Translator) Translate(container *corev1.Container, _ *device.Pod) (*resourceapi.DeviceClaim, error) {
+	var claim *resourceapi.DeviceClaim
+	for i := range t.chips {
+		chip := &t.chips[i]
+		countName, memoryName := corev1.ResourceName(chip.ResourceName), corev1.ResourceName(chip.ResourceMemoryName)
+		countQty, hasCount := container.Resources.Limits[countName]
+		memQty, hasMemory := container.Resources.Limits[memoryName]
+		if !hasCount {
+			if hasMemory {
+				return nil, device.Deniedf("container %s requests %s without %s", container.Name, memoryName, countName)
+			}
+			continue
+		}
+
+		request := resourceapi.DeviceRequest{
+			Name: strings.ToLower(chip.CommonWord),
+			Exactly: &resourceapi.ExactDeviceRequest{
+				DeviceClassName: chip.DRA.DeviceClassName,
+				AllocationMode:  resourceapi.DeviceAllocationModeExactCount,
+				Count:           countQty.Value(),
+				Selectors: []resourceapi.DeviceSelector{
+					device.CELSelector(fmt.Sprintf(`%s == %s`, device.Attribute(chip.DRA.DriverName, chip.DRA.ProductNameAttribute), device.CELString(chip.ChipName))),
+				},
+			},
+		}
+		var configs []resourceapi.DeviceClaimConfiguration
+		if hasMemory {
+			template, err := vnpuTemplate(container, chip, countQty.Value(), memQty.Value())
+			if err != nil {
+				return nil, err
+			}
+			if template != nil {
+				parameters, err := json.Marshal(&VNPUParameters{
+					TypeMeta: metav1.TypeMeta{APIVersion: ParametersAPIVersion, Kind: VNPUParametersKind},
+					Template: template.Name,
+				})
+				if err != nil {
+					return nil, err
+				}
+				configs = append(configs, resourceapi.DeviceClaimConfiguration{
+					Requests: []string{request.Name},
+					DeviceConfiguration: resourceapi.DeviceConfiguration{
+						Opaque: &resourceapi.OpaqueDeviceConfiguration{
+							Driver:     chip.DRA.DriverName,
+							Parameters: runtime.RawExtension{Raw: parameters},
+						},
+					},
+				})
+			}
+			device.RemoveResource(container, memoryName)
+		}
+		device.RemoveResource(container, countName)
+
+		if claim == nil {
+			claim = &resourceapi.DeviceClaim{}
+		}
+		claim.Requests = append(claim.Requests, request)
+		claim.Config = append(claim.Config, configs...)
+	}
+	return claim, nil
+}
+
+// vnpuTemplate returns the smallest template of the chip providing memory MiB, or nil if a whole NPU is needed.
+// Memory that no template provides is served by a whole NPU, up to the allocatable memory of the chip.
+func vnpuTemplate(container *corev1.Container, chip *config.VNPUConfig, count, memory int64) (*config.VNPUTemplate, error) {
+	if memory > chip.MemoryAllocatable {
+		return nil, device.Deniedf("container %s requests %dMi of %s, but an %s NPU provides at most %dMi",
+			container.Name, memory, chip.ResourceMemoryName, chip.ChipName, chip.MemoryAllocatable)
+	}
+	var template *config.VNPUTemplate
+	for i := range chip.Templates {
+		candidate := &chip.Templates[i]
+		if candidate.Memory >= memory && (template == nil || candidate.Memory < template.Memory) {
+			template = candidate
+		}
+	}
+	if template == nil {
+		return nil, nil
+	}
+	if count != 1 {
+		return nil, device.Deniedf("container %s requests %d %s with %dMi of memory each, but vNPUs are carved out of a single NPU; request 1 vNPU, or whole NPUs without %s",
+			container.Name, count, chip.ResourceName, memory, chip.ResourceMemoryName)
+	}
+	return template, nil
+}
@@ -0,0 +1,181 @@
+/*
+Copyright 2025 The HAMi Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ascend
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+
+	"github.com/Project-HAMi/HAMi-DRA/pkg/config"
+	"github.com/Project-HAMi/HAMi-DRA/pkg/device"
+)
+
+func ascend910B3() config.VNPUConfig {
+	return config.VNPUConfig{
+		ChipName:           "910B3",
+		CommonWord:         "Ascend910B3",
+		ResourceName:       "huawei.com/Ascend910B3",
+		ResourceMemoryName: "huawei.com/Ascend910B3-memory",
+		MemoryAllocatable:  65536,
+		MemoryCapacity:     65536,
+		AICore:             20,
+		AICPU:              7,
+		Templates: []config.VNPUTemplate{
+			{Name: "vir10_3c_32g", Memory: 32768, AICore: 10, AICPU: 3},
+			{Name: "vir05_1c_16g", Memory: 16384, AICore: 5, AICPU: 1},
+		},
+		DRA: config.VendorDRAConfig{
+			DriverName:           "npu.example.com",
+			DeviceClassName:      "npu.example.com",
+			ProductNameAttribute: "chipName",
+		},
+	}
+}
+
+func TestTranslate(t *testing.T) {
+	tests := []struct {
+		Name           string
+		Limits         corev1.ResourceList
+		ExpectDenied   bool
+		ExpectClaim    bool
+		ExpectCount    int64
+		ExpectTemplate string
+	}{
+		{
+			Name:   "other resources",
+			Limits: corev1.ResourceList{"nvidia.com/gpu": resource.MustParse("1")},
+		},
+		{
+			Name:        "whole npus",
+			Limits:      corev1.ResourceList{"huawei.com/Ascend910B3": resource.MustParse("2")},
+			ExpectClaim: true,
+			ExpectCount: 2,
+		},
+		{
+			Name: "smallest template",
+			Limits: corev1.ResourceList{
+				"huawei.com/Ascend910B3":        resource.MustParse("1"),
+				"huawei.com/Ascend910B3-memory": resource.MustParse("10000"),
+			},
+			ExpectClaim:    true,
+			ExpectCount:    1,
+			ExpectTemplate: "vir05_1c_16g",
+		},
+		{
+			Name: "larger template",
+			Limits: corev1.ResourceList{
+				"huawei.com/Ascend910B3":        resource.MustParse("1"),
+				"huawei.com/Ascend910B3-memory": resource.MustParse("20000"),
+			},
+			ExpectClaim:    true,
+			ExpectCount:    1,
+			ExpectTemplate: "vir10_3c_32g",
+		},
+		{
+			Name: "memory above every template",
+			Limits: corev1.ResourceList{
+				"huawei.com/Ascend910B3":        resource.MustParse("1"),
+				"huawei.com/Ascend910B3-memory": resource.MustParse("40000"),
+			},
+			ExpectClaim: true,
+			ExpectCount: 1,
+		},
+		{
+			Name: "memory above the chip",
+			Limits: corev1.ResourceList{
+				"huawei.com/Ascend910B3":        resource.MustParse("1"),
+				"huawei.com/Ascend910B3-memory": resource.MustParse("70000"),
+			},
+			ExpectDenied: true,
+		},
+		{
+			Name: "several vnpus",
+			Limits: corev1.ResourceList{
+				"huawei.com/Ascend910B3":        resource.MustParse("2"),
+				"huawei.com/Ascend910B3-memory": resource.MustParse("10000"),
+			},
+			ExpectDenied: true,
+		},
+		{
+			Name:         "memory without npu",
+			Limits:       corev1.ResourceList{"huawei.com/Ascend910B3-memory": resource.MustParse("10000")},
+			ExpectDenied: true,
+		},
+	}
+
+	translator := NewTranslator([]config.VNPUConfig{ascend910B3()})
+	for i := range tests {
+		tc := tests[i]
+
+		t.Run(tc.Name, func(t *testing.T) {
+			container := &corev1.Container{Name: "main", Resources: corev1.ResourceRequirements{Limits: tc.Limits.DeepCopy()}}
+
+			claim, err := translator.Translate(container, &device.Pod{})
+			var denied *device.DeniedError
+			if tc.ExpectDenied {
+				if !errors.As(err, &denied) {
+					t.Fatalf("Expect denied error, but got: %v", err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("No error is expected but got: %v", err)
+			}
+			if (claim != nil) != tc.ExpectClaim {
+				t.Fatalf("expect a claim: %t, but got: %v", tc.ExpectClaim, claim)
+			}
+			if claim == nil {
+				return
+			}
+			request := claim.Requests[0]
+			if request.Name != "ascend910b3" || request.Exactly.Count != tc.ExpectCount {
+				t.Fatalf("expect request ascend910b3 of %d devices, but got: %s of %d", tc.ExpectCount, request.Name, request.Exactly.Count)
+			}
+			if selector := request.Exactly.Selectors[0].CEL.Expression; selector != `device.attributes["npu.example.com"].chipName == "910B3"` {
+				t.Fatalf("expect a chip selector, but got: %s", selector)
+			}
+			if tc.ExpectTemplate == "" {
+				if len(claim.Config) != 0 {
+					t.Fatalf("expect no config, but got: %v", claim.Config)
+				}
+			} else {
+				parameters := &VNPUParameters{}
+				if err := json.Unmarshal(claim.Config[0].Opaque.Parameters.Raw, parameters); err != nil {
+					t.Fatalf("No error is expected but got: %v", err)
+				}
+				if parameters.Template != tc.ExpectTemplate || parameters.Kind != VNPUParametersKind {
+					t.Fatalf("expect template: %s, but got: %+v", tc.ExpectTemplate, parameters)
+				}
+			}
+			if len(container.Resources.Limits) != 0 {
+				t.Fatalf("expect the NPU resources to be removed, but got: %v", container.Resources.Limits)
+			}
+		})
+	}
+}
+
+func TestNewTranslatorDisabled(t *testing.T) {
+	vnpu := ascend910B3()
+	vnpu.DRA = config.VendorDRAConfig{}
+	if translator := NewTranslator([]config.VNPUConfig{vnpu}); translator != nil {
+		t.Fatalf("expect no translator without a DRA driver, but got: %v", translator)
+	}
+}
@@ -0,0 +1,134 @@
+/*
+Copyright 2025 The HAMi Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package awsneuron translates the AWS Neuron device and core resources into DRA device requests.
+package awsneuron
+
+import (
+	"fmt"
+
+	corev1 "k8s.io/api/core/v1"
+	resourceapi "k8s.io/api/resource/v1"
+
+	"github.com/Project-HAMi/HAMi-DRA/pkg/config"
+	"github.com/Project-HAMi/HAMi-DRA/pkg/device"
+)
+
+const (
+	// Name is the name of the AWS Neuron translator.
+	Name = "awsneuron"
+
+	// deviceRequestName and coreRequestName are the names of the requests for Neuron devices and for NeuronCores.
+	deviceRequestName = "neuron"
+	coreRequestName   = "neuroncore"
+)
+
+func init() {
+	device.Register(Name, func(deviceConfig *config.Config) device.DeviceTranslator {
+		if translator := NewTranslator(&deviceConfig.AWSNeuron); translator != nil {
+			return translator
+		}
+		return nil
+	})
+}
+
+// Translator translates Neuron devices and NeuronCores into exact counts of their DeviceClasses.
+// Neuron collectives need contiguous cores, so the cores of a request are kept on one device or on adjacent devices.
+type Translator struct {
+	config *config.AWSNeuronConfig
+}
+
+// Check if our Translator implements necessary interface
+var _ device.DeviceTranslator = &Translator{}
+
+// NewTranslator creates a translator for the AWS Neuron section of the device config, or returns nil if its DRA driver is not configured.
+func NewTranslator(neuronConfig *config.AWSNeuronConfig) *Translator {
+	if !neuronConfig.DRA.Enabled() {
+		return nil
+	}
+	return &Translator{config: neuronConfig}
+}
+
+// Name identifies the translator in logs and errors.
+func (t *Translator) Name() string {
+	return Name
+}
+
+// ResourceNames returns the device and core resources of AWS Neuron.
+func (t *Translator) ResourceNames() []corev1.ResourceName {
+	var names []corev1.ResourceName
+	for _, name := range []string{t.config.ResourceCountName, t.config.ResourceCoreName} {
+		if name != "" {
+			names = append(names, corev1.ResourceName(name))
+		}
+	}
+	return names
+}
+
+// Translate removes the Neuron resources from the container and returns the equivalent device request.
+// Requests of several cores must match the attribute naming their device, or the topology attribute if it is configured,
+// like requests of several devices. Without the topology attribute, requests of more cores than a device has are denied.
+func (t *Translator) Translate(container *corev1.Container, _ *device.Pod) (*resourceapi.DeviceClaim, error) {
+	deviceName, coreName := corev1.ResourceName(t.config.ResourceCountName), corev1.ResourceName(t.config.ResourceCoreName)
+	deviceQty, hasDevices := container.Resources.Limits[deviceName]
+	hasDevices = hasDevices && deviceName != ""
+	coreQty, hasCores := container.Resources.Limits[coreName]
+	hasCores = hasCores && coreName != ""
+
+	var requestName, deviceClassName, matchAttribute string
+	var count int64
+	switch {
+	case hasDevices && hasCores:
+		return nil, device.Deniedf("container %s requests both %s and %s, only one of them may be requested", container.Name, deviceName, coreName)
+	case hasDevices:
+		requestName, deviceClassName, count = deviceRequestName, t.config.DRA.DeviceClassName, deviceQty.Value()
+		matchAttribute = t.config.TopologyAttribute
+		device.RemoveResource(container, deviceName)
+	case hasCores:
+		requestName, deviceClassName, count = coreRequestName, t.config.CoreDeviceClassName, coreQty.Value()
+		matchAttribute = t.config.CoreDeviceAttribute
+		if t.config.TopologyAttribute != "" {
+			matchAttribute = t.config.TopologyAttribute
+		} else if perDevice := int64(t.config.CoresPerDevice); perDevice > 0 && count > perDevice {
+			// The cores would have to sit on one device, which has fewer, the claim could never be allocated.
+			return nil, device.Deniedf("container %s requests %d %s, but a Neuron device has %d cores and the cores of a request "+
+				"must sit on one device as no topology attribute is configured; request at most %d cores or whole Neuron devices",
+				container.Name, count, coreName, perDevice, perDevice)
+		}
+		device.RemoveResource(container, coreName)
+	default:
+		return nil, nil
+	}
+
+	claim := &resourceapi.DeviceClaim{
+		Requests: []resourceapi.DeviceRequest{{
+			Name: requestName,
+			Exactly: &resourceapi.ExactDeviceRequest{
+				DeviceClassName: deviceClassName,
+				AllocationMode:  resourceapi.DeviceAllocationModeExactCount,
+				Count:           count,
+			},
+		}},
+	}
+	if count > 1 && matchAttribute != "" {
+		attribute := resourceapi.FullyQualifiedName(fmt.Sprintf("%s/%s", t.config.DRA.DriverName, matchAttribute))
+		claim.Constraints = append(claim.Constraints, resourceapi.DeviceConstraint{
+			Requests:       []string{requestName},
+			MatchAttribute: &attribute,
+		})
+	}
+	return claim, nil
+}
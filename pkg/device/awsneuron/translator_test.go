@@ -0,0 +1,147 @@
+/*
+Copyright 2025 The HAMi Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package awsneuron
+
+import (
+	"errors"
+	"testing"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+
+	"github.com/Project-HAMi/HAMi-DRA/pkg/config"
+	"github.com/Project-HAMi/HAMi-DRA/pkg/device"
+)
+
+func TestTranslate(t *testing.T) {
+	tests := []struct {
+		Name              string
+		TopologyAttribute string
+		Limits            corev1.ResourceList
+		ExpectDenied      bool
+		ExpectRequest     string
+		ExpectDeviceClass string
+		ExpectCount       int64
+		ExpectConstraint  string
+	}{
+		{
+			Name:              "single core",
+			Limits:            corev1.ResourceList{"aws.amazon.com/neuroncore": resource.MustParse("1")},
+			ExpectRequest:     "neuroncore",
+			ExpectDeviceClass: "neuroncore.neuron.example.com",
+			ExpectCount:       1,
+		},
+		{
+			Name:              "cores on the same device",
+			Limits:            corev1.ResourceList{"aws.amazon.com/neuroncore": resource.MustParse("2")},
+			ExpectRequest:     "neuroncore",
+			ExpectDeviceClass: "neuroncore.neuron.example.com",
+			ExpectCount:       2,
+			ExpectConstraint:  "neuron.example.com/deviceIndex",
+		},
+		{
+			Name:              "cores on adjacent devices",
+			TopologyAttribute: "ringIndex",
+			Limits:            corev1.ResourceList{"aws.amazon.com/neuroncore": resource.MustParse("4")},
+			ExpectRequest:     "neuroncore",
+			ExpectDeviceClass: "neuroncore.neuron.example.com",
+			ExpectCount:       4,
+			ExpectConstraint:  "neuron.example.com/ringIndex",
+		},
+		{
+			Name:         "more cores than a device has",
+			Limits:       corev1.ResourceList{"aws.amazon.com/neuroncore": resource.MustParse("16")},
+			ExpectDenied: true,
+		},
+		{
+			Name:              "more cores than a device has on adjacent devices",
+			TopologyAttribute: "ringIndex",
+			Limits:            corev1.ResourceList{"aws.amazon.com/neuroncore": resource.MustParse("16")},
+			ExpectRequest:     "neuroncore",
+			ExpectDeviceClass: "neuroncore.neuron.example.com",
+			ExpectCount:       16,
+			ExpectConstraint:  "neuron.example.com/ringIndex",
+		},
+		{
+			Name:              "devices",
+			Limits:            corev1.ResourceList{"aws.amazon.com/neuron": resource.MustParse("2")},
+			ExpectRequest:     "neuron",
+			ExpectDeviceClass: "neuron.example.com",
+			ExpectCount:       2,
+		},
+		{
+			Name:              "adjacent devices",
+			TopologyAttribute: "ringIndex",
+			Limits:            corev1.ResourceList{"aws.amazon.com/neuron": resource.MustParse("2")},
+			ExpectRequest:     "neuron",
+			ExpectDeviceClass: "neuron.example.com",
+			ExpectCount:       2,
+			ExpectConstraint:  "neuron.example.com/ringIndex",
+		},
+		{
+			Name: "devices and cores",
+			Limits: corev1.ResourceList{
+				"aws.amazon.com/neuron":     resource.MustParse("1"),
+				"aws.amazon.com/neuroncore": resource.MustParse("1"),
+			},
+			ExpectDenied: true,
+		},
+	}
+
+	for i := range tests {
+		tc := tests[i]
+
+		t.Run(tc.Name, func(t *testing.T) {
+			deviceConfig := &config.Config{AWSNeuron: config.AWSNeuronConfig{
+				ResourceCountName: "aws.amazon.com/neuron",
+				ResourceCoreName:  "aws.amazon.com/neuroncore",
+				TopologyAttribute: tc.TopologyAttribute,
+				DRA:               config.VendorDRAConfig{DriverName: "neuron.example.com"},
+			}}
+			config.SetDefaults(deviceConfig)
+			translator := NewTranslator(&deviceConfig.AWSNeuron)
+			container := &corev1.Container{Name: "main", Resources: corev1.ResourceRequirements{Limits: tc.Limits}}
+
+			claim, err := translator.Translate(container, &device.Pod{})
+			var denied *device.DeniedError
+			if tc.ExpectDenied {
+				if !errors.As(err, &denied) {
+					t.Fatalf("Expect denied error, but got: %v", err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("No error is expected but got: %v", err)
+			}
+			request := claim.Requests[0]
+			if request.Name != tc.ExpectRequest || request.Exactly.DeviceClassName != tc.ExpectDeviceClass || request.Exactly.Count != tc.ExpectCount {
+				t.Fatalf("expect %d devices of %s in request %s, but got: %d devices of %s in request %s",
+					tc.ExpectCount, tc.ExpectDeviceClass, tc.ExpectRequest, request.Exactly.Count, request.Exactly.DeviceClassName, request.Name)
+			}
+			if tc.ExpectConstraint == "" {
+				if len(claim.Constraints) != 0 {
+					t.Fatalf("expect no constraint, but got: %v", claim.Constraints)
+				}
+			} else if len(claim.Constraints) != 1 || string(*claim.Constraints[0].MatchAttribute) != tc.ExpectConstraint {
+				t.Fatalf("expect constraint matching %s, but got: %v", tc.ExpectConstraint, claim.Constraints)
+			}
+			if len(container.Resources.Limits) != 0 {
+				t.Fatalf("expect the resources to be removed, but got: %v", container.Resources.Limits)
+			}
+		})
+	}
+}
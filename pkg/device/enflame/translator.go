@@ -0,0 +1,136 @@
+/*
+Copyright 2025 The HAMi Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package enflame translates the Enflame GCU and vGCU resources into DRA device requests.
+package enflame
+
+import (
+	corev1 "k8s.io/api/core/v1"
+	resourceapi "k8s.io/api/resource/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+
+	"github.com/Project-HAMi/HAMi-DRA/pkg/config"
+	"github.com/Project-HAMi/HAMi-DRA/pkg/device"
+)
+
+const (
+	// Name is the name of the Enflame translator.
+	Name = "enflame"
+
+	// gcuRequestName and vgcuRequestName are the names of the requests for whole GCUs and for vGCUs.
+	gcuRequestName  = "gcu"
+	vgcuRequestName = "vgcu"
+
+	// maxPercentage is the share of a whole GCU.
+	maxPercentage = 100
+)
+
+func init() {
+	device.Register(Name, func(deviceConfig *config.Config) device.DeviceTranslator {
+		if translator := NewTranslator(&deviceConfig.Enflame); translator != nil {
+			return translator
+		}
+		return nil
+	})
+}
+
+// Translator translates whole GCUs into exact counts and vGCUs into shares of the consumable capacity of a GCU.
+type Translator struct {
+	config *config.EnflameConfig
+}
+
+// Check if our Translator implements necessary interface
+var _ device.DeviceTranslator = &Translator{}
+
+// NewTranslator creates a translator for the Enflame section of the device config, or returns nil if its DRA driver is not configured.
+func NewTranslator(enflameConfig *config.EnflameConfig) *Translator {
+	if !enflameConfig.DRA.Enabled() {
+		return nil
+	}
+	return &Translator{config: enflameConfig}
+}
+
+// Name identifies the translator in logs and errors.
+func (t *Translator) Name() string {
+	return Name
+}
+
+// ResourceNames returns the GCU and vGCU resources of Enflame.
+func (t *Translator) ResourceNames() []corev1.ResourceName {
+	var names []corev1.ResourceName
+	for _, name := range []string{t.config.ResourceNameGCU, t.config.ResourceNameVGCU, t.config.ResourceNameVGCUPercentage} {
+		if name != "" {
+			names = append(names, corev1.ResourceName(name))
+		}
+	}
+	return names
+}
+
+// Translate removes the Enflame resources from the container and returns the equivalent device request.
+// The vGCU percentage must be an integer between 1 and 100, it is requested from every vGCU of the container.
+func (t *Translator) Translate(container *corev1.Container, _ *device.Pod) (*resourceapi.DeviceClaim, error) {
+	gcuName := corev1.ResourceName(t.config.ResourceNameGCU)
+	vgcuName := corev1.ResourceName(t.config.ResourceNameVGCU)
+	percentageName := corev1.ResourceName(t.config.ResourceNameVGCUPercentage)
+
+	gcuQty, hasGCU := container.Resources.Limits[gcuName]
+	hasGCU = hasGCU && gcuName != ""
+	vgcuQty, hasVGCU := container.Resources.Limits[vgcuName]
+	hasVGCU = hasVGCU && vgcuName != ""
+	percentageQty, hasPercentage := container.Resources.Limits[percentageName]
+	hasPercentage = hasPercentage && percentageName != ""
+
+	switch {
+	case hasGCU && (hasVGCU || hasPercentage):
+		return nil, device.Deniedf("container %s requests both whole GCUs %s and vGCUs, only one of them may be requested", container.Name, gcuName)
+	case hasGCU:
+		device.RemoveResource(container, gcuName)
+		return t.deviceClaim(gcuRequestName, gcuQty.Value(), nil), nil
+	case !hasVGCU:
+		if hasPercentage {
+			return nil, device.Deniedf("container %s requests %s without %s", container.Name, percentageName, vgcuName)
+		}
+		return nil, nil
+	}
+
+	var capacity *resourceapi.CapacityRequirements
+	if hasPercentage {
+		if percentageQty.MilliValue()%1000 != 0 || percentageQty.Value() < 1 || percentageQty.Value() > maxPercentage {
+			return nil, device.Deniedf("container %s requests %s %s, but it must be an integer between 1 and %d", container.Name, percentageQty.String(), percentageName, maxPercentage)
+		}
+		capacity = &resourceapi.CapacityRequirements{Requests: map[resourceapi.QualifiedName]resource.Quantity{
+			resourceapi.QualifiedName(t.config.PercentageCapacity): *resource.NewQuantity(percentageQty.Value(), resource.DecimalSI),
+		}}
+		device.RemoveResource(container, percentageName)
+	}
+	device.RemoveResource(container, vgcuName)
+	return t.deviceClaim(vgcuRequestName, vgcuQty.Value(), capacity), nil
+}
+
+// deviceClaim returns a claim of count devices of the Enflame DeviceClass.
+func (t *Translator) deviceClaim(requestName string, count int64, capacity *resourceapi.CapacityRequirements) *resourceapi.DeviceClaim {
+	return &resourceapi.DeviceClaim{
+		Requests: []resourceapi.DeviceRequest{{
+			Name: requestName,
+			Exactly: &resourceapi.ExactDeviceRequest{
+				DeviceClassName: t.config.DRA.DeviceClassName,
+				AllocationMode:  resourceapi.DeviceAllocationModeExactCount,
+				Count:           count,
+				Capacity:        capacity,
+			},
+		}},
+	}
+}
@@ -0,0 +1,136 @@
+/*
+Copyright 2025 The HAMi Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package enflame
+
+import (
+	"errors"
+	"testing"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+
+	"github.com/Project-HAMi/HAMi-DRA/pkg/config"
+	"github.com/Project-HAMi/HAMi-DRA/pkg/device"
+)
+
+func TestTranslate(t *testing.T) {
+	tests := []struct {
+		Name             string
+		Limits           corev1.ResourceList
+		ExpectDenied     bool
+		ExpectRequest    string
+		ExpectCount      int64
+		ExpectPercentage int64
+	}{
+		{
+			Name:          "whole gcus",
+			Limits:        corev1.ResourceList{"enflame.com/gcu": resource.MustParse("2")},
+			ExpectRequest: "gcu",
+			ExpectCount:   2,
+		},
+		{
+			Name: "vgcu percentage",
+			Limits: corev1.ResourceList{
+				"enflame.com/vgcu":            resource.MustParse("1"),
+				"enflame.com/vgcu-percentage": resource.MustParse("25"),
+			},
+			ExpectRequest:    "vgcu",
+			ExpectCount:      1,
+			ExpectPercentage: 25,
+		},
+		{
+			Name:          "vgcu without percentage",
+			Limits:        corev1.ResourceList{"enflame.com/vgcu": resource.MustParse("1")},
+			ExpectRequest: "vgcu",
+			ExpectCount:   1,
+		},
+		{
+			Name: "percentage above 100",
+			Limits: corev1.ResourceList{
+				"enflame.com/vgcu":            resource.MustParse("1"),
+				"enflame.com/vgcu-percentage": resource.MustParse("101"),
+			},
+			ExpectDenied: true,
+		},
+		{
+			Name: "non-integer percentage",
+			Limits: corev1.ResourceList{
+				"enflame.com/vgcu":            resource.MustParse("1"),
+				"enflame.com/vgcu-percentage": resource.MustParse("12.5"),
+			},
+			ExpectDenied: true,
+		},
+		{
+			Name:         "percentage without vgcu",
+			Limits:       corev1.ResourceList{"enflame.com/vgcu-percentage": resource.MustParse("50")},
+			ExpectDenied: true,
+		},
+		{
+			Name: "gcus and vgcus",
+			Limits: corev1.ResourceList{
+				"enflame.com/gcu":  resource.MustParse("1"),
+				"enflame.com/vgcu": resource.MustParse("1"),
+			},
+			ExpectDenied: true,
+		},
+	}
+
+	for i := range tests {
+		tc := tests[i]
+
+		t.Run(tc.Name, func(t *testing.T) {
+			deviceConfig := &config.Config{Enflame: config.EnflameConfig{
+				ResourceNameGCU:            "enflame.com/gcu",
+				ResourceNameVGCU:           "enflame.com/vgcu",
+				ResourceNameVGCUPercentage: "enflame.com/vgcu-percentage",
+				DRA:                        config.VendorDRAConfig{DriverName: "gcu.example.com"},
+			}}
+			config.SetDefaults(deviceConfig)
+			translator := NewTranslator(&deviceConfig.Enflame)
+			container := &corev1.Container{Name: "main", Resources: corev1.ResourceRequirements{Limits: tc.Limits}}
+
+			claim, err := translator.Translate(container, &device.Pod{})
+			var denied *device.DeniedError
+			if tc.ExpectDenied {
+				if !errors.As(err, &denied) {
+					t.Fatalf("Expect denied error, but got: %v", err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("No error is expected but got: %v", err)
+			}
+			request := claim.Requests[0]
+			if request.Name != tc.ExpectRequest || request.Exactly.Count != tc.ExpectCount {
+				t.Fatalf("expect %d %s, but got: %d %s", tc.ExpectCount, tc.ExpectRequest, request.Exactly.Count, request.Name)
+			}
+			if request.Exactly.DeviceClassName != "gcu.example.com" {
+				t.Fatalf("expect device class gcu.example.com, but got: %s", request.Exactly.DeviceClassName)
+			}
+			if tc.ExpectPercentage == 0 {
+				if request.Exactly.Capacity != nil {
+					t.Fatalf("expect no capacity, but got: %v", request.Exactly.Capacity)
+				}
+			} else if percentage := request.Exactly.Capacity.Requests["percentage"]; percentage.Value() != tc.ExpectPercentage {
+				t.Fatalf("expect percentage: %d, but got: %s", tc.ExpectPercentage, percentage.String())
+			}
+			if len(container.Resources.Limits) != 0 {
+				t.Fatalf("expect the resources to be removed, but got: %v", container.Resources.Limits)
+			}
+		})
+	}
+}
@@ -0,0 +1,67 @@
+/*
+Copyright 2025 The HAMi Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package device
+
+import (
+	"fmt"
+	"strconv"
+
+	corev1 "k8s.io/api/core/v1"
+	resourceapi "k8s.io/api/resource/v1"
+)
+
+// DeniedError is returned for requests that are rejected rather than failed.
+type DeniedError struct {
+	Message string
+}
+
+func (e *DeniedError) Error() string {
+	return e.Message
+}
+
+// Deniedf returns a DeniedError with the formatted message.
+func Deniedf(format string, args ...any) error {
+	return &DeniedError{Message: fmt.Sprintf(format, args...)}
+}
+
+// RemoveResource removes a resource from both Requests and Limits of the container.
+func RemoveResource(container *corev1.Container, resourceName corev1.ResourceName) {
+	if container.Resources.Requests != nil {
+		delete(container.Resources.Requests, resourceName)
+	}
+	if container.Resources.Limits != nil {
+		delete(container.Resources.Limits, resourceName)
+	}
+}
+
+// Attribute returns the CEL expression of the named attribute of a driver.
+func Attribute(driverName, name string) string {
+	return fmt.Sprintf(`device.attributes["%s"].%s`, driverName, name)
+}
+
+// CELString returns the value as a CEL string literal. Go escapes are valid in CEL strings,
+// so values such as pod annotations cannot end the literal and inject expressions.
+func CELString(value string) string {
+	return strconv.Quote(value)
+}
+
+// CELSelector returns a device selector evaluating the CEL expression.
+func CELSelector(expression string) resourceapi.DeviceSelector {
+	return resourceapi.DeviceSelector{
+		CEL: &resourceapi.CELDeviceSelector{Expression: expression},
+	}
+}
@@ -0,0 +1,63 @@
+/*
+Copyright 2025 The HAMi Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package device
+
+import (
+	"testing"
+
+	"github.com/google/cel-go/cel"
+)
+
+func TestCELString(t *testing.T) {
+	tests := []struct {
+		Name  string
+		Value string
+	}{
+		{Name: "plain", Value: "A100"},
+		{Name: "quotes", Value: `A100" || true || "`},
+		{Name: "backslashes", Value: `A100\" || true || \`},
+		{Name: "control characters", Value: "A100\n\t\x00"},
+		{Name: "unicode", Value: "昇腾910B"},
+	}
+
+	env, err := cel.NewEnv(cel.Variable("value", cel.StringType))
+	if err != nil {
+		t.Fatalf("No error is expected but got: %v", err)
+	}
+	for i := range tests {
+		tc := tests[i]
+
+		t.Run(tc.Name, func(t *testing.T) {
+			// The literal must be one string equal to the value, whatever characters it holds.
+			ast, issues := env.Compile("value == " + CELString(tc.Value))
+			if issues != nil && issues.Err() != nil {
+				t.Fatalf("No error is expected but got: %v", issues.Err())
+			}
+			program, err := env.Program(ast)
+			if err != nil {
+				t.Fatalf("No error is expected but got: %v", err)
+			}
+			out, _, err := program.Eval(map[string]any{"value": tc.Value})
+			if err != nil {
+				t.Fatalf("No error is expected but got: %v", err)
+			}
+			if out.Value() != true {
+				t.Fatalf("expect the literal to equal %q, but got: %v", tc.Value, out)
+			}
+		})
+	}
+}
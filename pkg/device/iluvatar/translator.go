@@ -0,0 +1,123 @@
+/*
+Copyright 2025 The HAMi Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package iluvatar translates the resources of the Iluvatar chip families into DRA device requests.
+package iluvatar
+
+import (
+	"fmt"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+	resourceapi "k8s.io/api/resource/v1"
+
+	"github.com/Project-HAMi/HAMi-DRA/pkg/config"
+	"github.com/Project-HAMi/HAMi-DRA/pkg/device"
+	"github.com/Project-HAMi/HAMi-DRA/pkg/device/vgpu"
+)
+
+// Name is the name of the Iluvatar translator.
+const Name = "iluvatar"
+
+func init() {
+	device.Register(Name, func(deviceConfig *config.Config) device.DeviceTranslator {
+		if translator := NewTranslator(deviceConfig.Iluvatars); translator != nil {
+			return translator
+		}
+		return nil
+	})
+}
+
+// chip is a chip family whose resources are translated.
+type chip struct {
+	config     *config.IluvatarConfig
+	translator *vgpu.Translator
+}
+
+// Translator translates the count, vMem and vCore resources of every Iluvatar chip family whose DRA driver is configured,
+// pinning the devices to the family by their product name.
+type Translator struct {
+	chips []chip
+}
+
+// Check if our Translator implements necessary interface
+var _ device.DeviceTranslator = &Translator{}
+
+// NewTranslator creates a translator for the chip families whose DRA driver is configured, or returns nil if there is none.
+func NewTranslator(iluvatars []config.IluvatarConfig) *Translator {
+	var chips []chip
+	for i := range iluvatars {
+		iluvatar := &iluvatars[i]
+		translator := vgpu.NewTranslator(vgpu.Spec{
+			Name:               Name,
+			RequestName:        strings.ToLower(iluvatar.CommonWord),
+			ResourceCountName:  iluvatar.ResourceCountName,
+			ResourceMemoryName: iluvatar.ResourceMemoryName,
+			ResourceCoreName:   iluvatar.ResourceCoreName,
+			MemoryUnit:         iluvatar.MemoryUnit,
+			// vCore is a percentage of a GPU.
+			MaxCores: 100,
+			DRA:      iluvatar.DRA,
+		})
+		if translator != nil {
+			chips = append(chips, chip{config: iluvatar, translator: translator})
+		}
+	}
+	if len(chips) == 0 {
+		return nil
+	}
+	return &Translator{chips: chips}
+}
+
+// Name identifies the translator in logs and errors.
+func (t *Translator) Name() string {
+	return Name
+}
+
+// ResourceNames returns the resources of the translated chip families.
+func (t *Translator) ResourceNames() []corev1.ResourceName {
+	var names []corev1.ResourceName
+	for _, chip := range t.chips {
+		names = append(names, chip.translator.ResourceNames()...)
+	}
+	return names
+}
+
+// Translate removes the resources of every chip family from the container and returns one request per family,
+// selecting the devices whose product name is the chip name of the family.
+func (t *Translator) Translate(container *corev1.Container, pod *device.Pod) (*resourceapi.DeviceClaim, error) {
+	var claim *resourceapi.DeviceClaim
+	for _, chip := range t.chips {
+		chipClaim, err := chip.translator.Translate(container, pod)
+		if err != nil {
+			return nil, err
+		}
+		if chipClaim == nil {
+			continue
+		}
+		dra := chip.config.DRA
+		for i := range chipClaim.Requests {
+			exactly := chipClaim.Requests[i].Exactly
+			exactly.Selectors = append(exactly.Selectors,
+				device.CELSelector(fmt.Sprintf(`%s == %s`, device.Attribute(dra.DriverName, dra.ProductNameAttribute), device.CELString(chip.config.ChipName))))
+		}
+		if claim == nil {
+			claim = &resourceapi.DeviceClaim{}
+		}
+		claim.Requests = append(claim.Requests, chipClaim.Requests...)
+	}
+	return claim, nil
+}
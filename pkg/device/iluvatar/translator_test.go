@@ -0,0 +1,149 @@
+/*
+Copyright 2025 The HAMi Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package iluvatar
+
+import (
+	"errors"
+	"testing"
+
+	corev1 "k8s.io/api/core/v1"
+	resourceapi "k8s.io/api/resource/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+
+	"github.com/Project-HAMi/HAMi-DRA/pkg/config"
+	"github.com/Project-HAMi/HAMi-DRA/pkg/device"
+)
+
+// iluvatars returns two chip families of the chart, only the BI-V150 one having a DRA driver.
+func iluvatars() []config.IluvatarConfig {
+	deviceConfig := &config.Config{Iluvatars: []config.IluvatarConfig{
+		{
+			ChipName:           "MR-V100",
+			CommonWord:         "MR-V100",
+			ResourceCountName:  "iluvatar.ai/MR-V100-vgpu",
+			ResourceMemoryName: "iluvatar.ai/MR-V100.vMem",
+			ResourceCoreName:   "iluvatar.ai/MR-V100.vCore",
+		},
+		{
+			ChipName:           "BI-V150",
+			CommonWord:         "BI-V150",
+			ResourceCountName:  "iluvatar.ai/BI-V150-vgpu",
+			ResourceMemoryName: "iluvatar.ai/BI-V150.vMem",
+			ResourceCoreName:   "iluvatar.ai/BI-V150.vCore",
+			DRA:                config.VendorDRAConfig{DriverName: "gpu.iluvatar.example.com"},
+		},
+	}}
+	config.SetDefaults(deviceConfig)
+	return deviceConfig.Iluvatars
+}
+
+func TestTranslate(t *testing.T) {
+	tests := []struct {
+		Name          string
+		Limits        corev1.ResourceList
+		ExpectDenied  bool
+		ExpectClaim   bool
+		ExpectRequest string
+		ExpectMemory  string
+		ExpectCores   string
+	}{
+		{
+			Name: "vgpu with vMem and vCore",
+			Limits: corev1.ResourceList{
+				"iluvatar.ai/BI-V150-vgpu":  resource.MustParse("1"),
+				"iluvatar.ai/BI-V150.vMem":  resource.MustParse("16"),
+				"iluvatar.ai/BI-V150.vCore": resource.MustParse("50"),
+			},
+			ExpectClaim:   true,
+			ExpectRequest: "bi-v150",
+			ExpectMemory:  "4Gi",
+			ExpectCores:   "50",
+		},
+		{
+			Name:          "whole vgpus",
+			Limits:        corev1.ResourceList{"iluvatar.ai/BI-V150-vgpu": resource.MustParse("2")},
+			ExpectClaim:   true,
+			ExpectRequest: "bi-v150",
+		},
+		{
+			Name:   "family without driver",
+			Limits: corev1.ResourceList{"iluvatar.ai/MR-V100-vgpu": resource.MustParse("1")},
+		},
+		{
+			Name: "vCore above a gpu",
+			Limits: corev1.ResourceList{
+				"iluvatar.ai/BI-V150-vgpu":  resource.MustParse("1"),
+				"iluvatar.ai/BI-V150.vCore": resource.MustParse("120"),
+			},
+			ExpectDenied: true,
+		},
+		{
+			Name:         "vMem without vgpu",
+			Limits:       corev1.ResourceList{"iluvatar.ai/BI-V150.vMem": resource.MustParse("16")},
+			ExpectDenied: true,
+		},
+	}
+
+	translator := NewTranslator(iluvatars())
+	for i := range tests {
+		tc := tests[i]
+
+		t.Run(tc.Name, func(t *testing.T) {
+			container := &corev1.Container{Name: "main", Resources: corev1.ResourceRequirements{Limits: tc.Limits}}
+
+			claim, err := translator.Translate(container, &device.Pod{})
+			var denied *device.DeniedError
+			if tc.ExpectDenied {
+				if !errors.As(err, &denied) {
+					t.Fatalf("Expect denied error, but got: %v", err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("No error is expected but got: %v", err)
+			}
+			if !tc.ExpectClaim {
+				if claim != nil {
+					t.Fatalf("expect no claim, but got: %v", claim)
+				}
+				return
+			}
+			if len(claim.Requests) != 1 {
+				t.Fatalf("expect one request, but got: %v", claim.Requests)
+			}
+			request := claim.Requests[0]
+			if request.Name != tc.ExpectRequest {
+				t.Fatalf("expect request: %s, but got: %s", tc.ExpectRequest, request.Name)
+			}
+			expectSelector := `device.attributes["gpu.iluvatar.example.com"].productName == "BI-V150"`
+			if selectors := request.Exactly.Selectors; len(selectors) != 1 || selectors[0].CEL.Expression != expectSelector {
+				t.Fatalf("expect selector: %s, but got: %v", expectSelector, selectors)
+			}
+			for name, expect := range map[resourceapi.QualifiedName]string{"memory": tc.ExpectMemory, "cores": tc.ExpectCores} {
+				if expect == "" {
+					continue
+				}
+				if qty := request.Exactly.Capacity.Requests[name]; qty.Cmp(resource.MustParse(expect)) != 0 {
+					t.Fatalf("expect %s capacity: %s, but got: %s", name, expect, qty.String())
+				}
+			}
+			if len(container.Resources.Limits) != 0 {
+				t.Fatalf("expect the resources to be removed, but got: %v", container.Resources.Limits)
+			}
+		})
+	}
+}
@@ -0,0 +1,148 @@
+/*
+Copyright 2025 The HAMi Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package kunlun translates the Kunlunxin XPU and vXPU resources into DRA device requests.
+package kunlun
+
+import (
+	corev1 "k8s.io/api/core/v1"
+	resourceapi "k8s.io/api/resource/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+
+	"github.com/Project-HAMi/HAMi-DRA/pkg/config"
+	"github.com/Project-HAMi/HAMi-DRA/pkg/device"
+)
+
+const (
+	// Name is the name of the Kunlun translator.
+	Name = "kunlun"
+
+	// xpuRequestName and vxpuRequestName are the names of the requests for whole XPUs and for vXPUs.
+	xpuRequestName  = "xpu"
+	vxpuRequestName = "vxpu"
+)
+
+func init() {
+	device.Register(Name, func(deviceConfig *config.Config) device.DeviceTranslator {
+		if translator := NewTranslator(&deviceConfig.Kunlun); translator != nil {
+			return translator
+		}
+		return nil
+	})
+}
+
+// Translator translates whole XPUs into exact counts and vXPUs into the memory capacity of a partition.
+type Translator struct {
+	config *config.KunlunConfig
+}
+
+// Check if our Translator implements necessary interface
+var _ device.DeviceTranslator = &Translator{}
+
+// NewTranslator creates a translator for the Kunlun section of the device config, or returns nil if its DRA driver is not configured.
+func NewTranslator(kunlunConfig *config.KunlunConfig) *Translator {
+	if !kunlunConfig.DRA.Enabled() {
+		return nil
+	}
+	return &Translator{config: kunlunConfig}
+}
+
+// Name identifies the translator in logs and errors.
+func (t *Translator) Name() string {
+	return Name
+}
+
+// ResourceNames returns the XPU and vXPU resources of Kunlun.
+func (t *Translator) ResourceNames() []corev1.ResourceName {
+	var names []corev1.ResourceName
+	for _, name := range []string{t.config.ResourceCountName, t.config.ResourceVCountName, t.config.ResourceVMemoryName} {
+		if name != "" {
+			names = append(names, corev1.ResourceName(name))
+		}
+	}
+	return names
+}
+
+// Translate removes the Kunlun resources from the container and returns the equivalent device request.
+// The vXPU memory in MiB is rounded up to the smallest partition holding it, memory above every partition is denied.
+func (t *Translator) Translate(container *corev1.Container, _ *device.Pod) (*resourceapi.DeviceClaim, error) {
+	xpuName := corev1.ResourceName(t.config.ResourceCountName)
+	vxpuName := corev1.ResourceName(t.config.ResourceVCountName)
+	memoryName := corev1.ResourceName(t.config.ResourceVMemoryName)
+
+	xpuQty, hasXPU := container.Resources.Limits[xpuName]
+	hasXPU = hasXPU && xpuName != ""
+	vxpuQty, hasVXPU := container.Resources.Limits[vxpuName]
+	hasVXPU = hasVXPU && vxpuName != ""
+	memQty, hasMemory := container.Resources.Limits[memoryName]
+	hasMemory = hasMemory && memoryName != ""
+
+	switch {
+	case hasXPU && (hasVXPU || hasMemory):
+		return nil, device.Deniedf("container %s requests both whole XPUs %s and vXPUs, only one of them may be requested", container.Name, xpuName)
+	case hasXPU:
+		device.RemoveResource(container, xpuName)
+		return t.deviceClaim(xpuRequestName, xpuQty.Value(), nil), nil
+	case !hasVXPU:
+		if hasMemory {
+			return nil, device.Deniedf("container %s requests %s without %s", container.Name, memoryName, vxpuName)
+		}
+		return nil, nil
+	}
+
+	var capacity *resourceapi.CapacityRequirements
+	if hasMemory {
+		size, err := t.partitionSize(container, memQty.Value())
+		if err != nil {
+			return nil, err
+		}
+		capacity = &resourceapi.CapacityRequirements{Requests: map[resourceapi.QualifiedName]resource.Quantity{
+			"memory": *resource.NewQuantity(size*1024*1024, resource.BinarySI),
+		}}
+		device.RemoveResource(container, memoryName)
+	}
+	device.RemoveResource(container, vxpuName)
+	return t.deviceClaim(vxpuRequestName, vxpuQty.Value(), capacity), nil
+}
+
+// partitionSize returns the smallest partition size in MiB holding memory MiB.
+func (t *Translator) partitionSize(container *corev1.Container, memory int64) (int64, error) {
+	sizes := t.config.VXPUMemorySizes
+	if memory > 0 {
+		for _, size := range sizes {
+			if size >= memory {
+				return size, nil
+			}
+		}
+	}
+	return 0, device.Deniedf("container %s requests %dMi of %s, but vXPUs are partitions of %v MiB",
+		container.Name, memory, t.config.ResourceVMemoryName, sizes)
+}
+
+// deviceClaim returns a claim of count devices of the Kunlun DeviceClass.
+func (t *Translator) deviceClaim(requestName string, count int64, capacity *resourceapi.CapacityRequirements) *resourceapi.DeviceClaim {
+	return &resourceapi.DeviceClaim{
+		Requests: []resourceapi.DeviceRequest{{
+			Name: requestName,
+			Exactly: &resourceapi.ExactDeviceRequest{
+				DeviceClassName: t.config.DRA.DeviceClassName,
+				AllocationMode:  resourceapi.DeviceAllocationModeExactCount,
+				Count:           count,
+				Capacity:        capacity,
+			},
+		}},
+	}
+}
@@ -0,0 +1,129 @@
+/*
+Copyright 2025 The HAMi Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package kunlun
+
+import (
+	"errors"
+	"testing"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+
+	"github.com/Project-HAMi/HAMi-DRA/pkg/config"
+	"github.com/Project-HAMi/HAMi-DRA/pkg/device"
+)
+
+func TestTranslate(t *testing.T) {
+	tests := []struct {
+		Name          string
+		Limits        corev1.ResourceList
+		ExpectDenied  bool
+		ExpectRequest string
+		ExpectCount   int64
+		ExpectMemory  string
+	}{
+		{
+			Name:          "whole xpus",
+			Limits:        corev1.ResourceList{"kunlunxin.com/xpu": resource.MustParse("4")},
+			ExpectRequest: "xpu",
+			ExpectCount:   4,
+		},
+		{
+			Name: "vxpu memory of a partition",
+			Limits: corev1.ResourceList{
+				"kunlunxin.com/vxpu":        resource.MustParse("1"),
+				"kunlunxin.com/vxpu-memory": resource.MustParse("24576"),
+			},
+			ExpectRequest: "vxpu",
+			ExpectCount:   1,
+			ExpectMemory:  "24Gi",
+		},
+		{
+			Name: "vxpu memory rounded up to a partition",
+			Limits: corev1.ResourceList{
+				"kunlunxin.com/vxpu":        resource.MustParse("2"),
+				"kunlunxin.com/vxpu-memory": resource.MustParse("30000"),
+			},
+			ExpectRequest: "vxpu",
+			ExpectCount:   2,
+			ExpectMemory:  "48Gi",
+		},
+		{
+			Name: "vxpu memory above every partition",
+			Limits: corev1.ResourceList{
+				"kunlunxin.com/vxpu":        resource.MustParse("1"),
+				"kunlunxin.com/vxpu-memory": resource.MustParse("100000"),
+			},
+			ExpectDenied: true,
+		},
+		{
+			Name:         "vxpu memory without vxpu",
+			Limits:       corev1.ResourceList{"kunlunxin.com/vxpu-memory": resource.MustParse("24576")},
+			ExpectDenied: true,
+		},
+		{
+			Name: "xpus and vxpus",
+			Limits: corev1.ResourceList{
+				"kunlunxin.com/xpu":  resource.MustParse("1"),
+				"kunlunxin.com/vxpu": resource.MustParse("1"),
+			},
+			ExpectDenied: true,
+		},
+	}
+
+	for i := range tests {
+		tc := tests[i]
+
+		t.Run(tc.Name, func(t *testing.T) {
+			deviceConfig := &config.Config{Kunlun: config.KunlunConfig{
+				ResourceCountName:   "kunlunxin.com/xpu",
+				ResourceVCountName:  "kunlunxin.com/vxpu",
+				ResourceVMemoryName: "kunlunxin.com/vxpu-memory",
+				DRA:                 config.VendorDRAConfig{DriverName: "xpu.example.com"},
+			}}
+			config.SetDefaults(deviceConfig)
+			translator := NewTranslator(&deviceConfig.Kunlun)
+			container := &corev1.Container{Name: "main", Resources: corev1.ResourceRequirements{Limits: tc.Limits}}
+
+			claim, err := translator.Translate(container, &device.Pod{})
+			var denied *device.DeniedError
+			if tc.ExpectDenied {
+				if !errors.As(err, &denied) {
+					t.Fatalf("Expect denied error, but got: %v", err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("No error is expected but got: %v", err)
+			}
+			request := claim.Requests[0]
+			if request.Name != tc.ExpectRequest || request.Exactly.Count != tc.ExpectCount {
+				t.Fatalf("expect %d %s, but got: %d %s", tc.ExpectCount, tc.ExpectRequest, request.Exactly.Count, request.Name)
+			}
+			if tc.ExpectMemory == "" {
+				if request.Exactly.Capacity != nil {
+					t.Fatalf("expect no capacity, but got: %v", request.Exactly.Capacity)
+				}
+			} else if memory := request.Exactly.Capacity.Requests["memory"]; memory.Cmp(resource.MustParse(tc.ExpectMemory)) != 0 {
+				t.Fatalf("expect memory: %s, but got: %s", tc.ExpectMemory, memory.String())
+			}
+			if len(container.Resources.Limits) != 0 {
+				t.Fatalf("expect the resources to be removed, but got: %v", container.Resources.Limits)
+			}
+		})
+	}
+}
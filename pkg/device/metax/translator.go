@@ -0,0 +1,145 @@
+/*
+Copyright 2025 The HAMi Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package metax translates the MetaX GPU and sGPU resources into DRA device requests.
+package metax
+
+import (
+	"fmt"
+
+	corev1 "k8s.io/api/core/v1"
+	resourceapi "k8s.io/api/resource/v1"
+
+	"github.com/Project-HAMi/HAMi-DRA/pkg/config"
+	"github.com/Project-HAMi/HAMi-DRA/pkg/device"
+	"github.com/Project-HAMi/HAMi-DRA/pkg/device/vgpu"
+)
+
+const (
+	// Name is the name of the MetaX translator.
+	Name = "metax"
+
+	// gpuRequestName and sgpuRequestName are the names of the requests for whole GPUs and for sGPUs.
+	gpuRequestName  = "metax"
+	sgpuRequestName = "sgpu"
+)
+
+func init() {
+	device.Register(Name, func(deviceConfig *config.Config) device.DeviceTranslator {
+		if translator := NewTranslator(&deviceConfig.Metax); translator != nil {
+			return translator
+		}
+		return nil
+	})
+}
+
+// Translator translates the whole GPU resource of MetaX, and its sGPU resources like the other shared devices.
+type Translator struct {
+	config *config.MetaxConfig
+	// sgpu translates the sGPU resources, nil if they are not configured.
+	sgpu *vgpu.Translator
+}
+
+// Check if our Translator implements necessary interface
+var _ device.DeviceTranslator = &Translator{}
+
+// NewTranslator creates a translator for the MetaX section of the device config, or returns nil if its DRA driver is not configured.
+func NewTranslator(metaxConfig *config.MetaxConfig) *Translator {
+	if !metaxConfig.DRA.Enabled() {
+		return nil
+	}
+	return &Translator{
+		config: metaxConfig,
+		sgpu: vgpu.NewTranslator(vgpu.Spec{
+			Name:               Name,
+			RequestName:        sgpuRequestName,
+			ResourceCountName:  metaxConfig.ResourceVCountName,
+			ResourceMemoryName: metaxConfig.ResourceVMemoryName,
+			ResourceCoreName:   metaxConfig.ResourceVCoreName,
+			MemoryUnit:         metaxConfig.MemoryUnit,
+			// vcore is a percentage of a GPU.
+			MaxCores: 100,
+			DRA:      metaxConfig.DRA,
+		}),
+	}
+}
+
+// Name identifies the translator in logs and errors.
+func (t *Translator) Name() string {
+	return Name
+}
+
+// ResourceNames returns the GPU and sGPU resources of MetaX.
+func (t *Translator) ResourceNames() []corev1.ResourceName {
+	var names []corev1.ResourceName
+	if t.config.ResourceCountName != "" {
+		names = append(names, corev1.ResourceName(t.config.ResourceCountName))
+	}
+	if t.sgpu != nil {
+		names = append(names, t.sgpu.ResourceNames()...)
+	}
+	return names
+}
+
+// Translate removes the MetaX resources from the container and returns the equivalent device request.
+// With topology awareness, the GPUs or sGPUs of a multi-device request must share the interconnect domain.
+func (t *Translator) Translate(container *corev1.Container, pod *device.Pod) (*resourceapi.DeviceClaim, error) {
+	gpuName := corev1.ResourceName(t.config.ResourceCountName)
+	countQty, wholeGPUs := container.Resources.Limits[gpuName]
+	if wholeGPUs && gpuName != "" {
+		for _, name := range []string{t.config.ResourceVCountName, t.config.ResourceVMemoryName, t.config.ResourceVCoreName} {
+			if _, ok := container.Resources.Limits[corev1.ResourceName(name)]; ok && name != "" {
+				return nil, device.Deniedf("container %s requests both %s and %s, whole GPUs cannot be combined with sGPU resources", container.Name, gpuName, name)
+			}
+		}
+		device.RemoveResource(container, gpuName)
+		claim := &resourceapi.DeviceClaim{
+			Requests: []resourceapi.DeviceRequest{{
+				Name: gpuRequestName,
+				Exactly: &resourceapi.ExactDeviceRequest{
+					DeviceClassName: t.config.DRA.DeviceClassName,
+					AllocationMode:  resourceapi.DeviceAllocationModeExactCount,
+					Count:           countQty.Value(),
+				},
+			}},
+		}
+		t.addTopologyConstraint(claim)
+		return claim, nil
+	}
+
+	if t.sgpu == nil {
+		return nil, nil
+	}
+	claim, err := t.sgpu.Translate(container, pod)
+	if err != nil || claim == nil {
+		return nil, err
+	}
+	t.addTopologyConstraint(claim)
+	return claim, nil
+}
+
+// addTopologyConstraint keeps the devices of a multi-device request on one interconnect domain, if topology aware.
+func (t *Translator) addTopologyConstraint(claim *resourceapi.DeviceClaim) {
+	request := claim.Requests[0]
+	if !t.config.SGPUTopologyAware || request.Exactly.Count <= 1 {
+		return
+	}
+	attribute := resourceapi.FullyQualifiedName(fmt.Sprintf("%s/%s", t.config.DRA.DriverName, t.config.TopologyAttribute))
+	claim.Constraints = append(claim.Constraints, resourceapi.DeviceConstraint{
+		Requests:       []string{request.Name},
+		MatchAttribute: &attribute,
+	})
+}
@@ -0,0 +1,163 @@
+/*
+Copyright 2025 The HAMi Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package metax
+
+import (
+	"errors"
+	"testing"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+
+	"github.com/Project-HAMi/HAMi-DRA/pkg/config"
+	"github.com/Project-HAMi/HAMi-DRA/pkg/device"
+)
+
+func TestTranslate(t *testing.T) {
+	tests := []struct {
+		Name             string
+		TopologyAware    bool
+		Limits           corev1.ResourceList
+		ExpectDenied     bool
+		ExpectRequest    string
+		ExpectMemory     string
+		ExpectConstraint bool
+	}{
+		{
+			Name:             "topology aware whole gpus",
+			TopologyAware:    true,
+			Limits:           corev1.ResourceList{"metax-tech.com/gpu": resource.MustParse("2")},
+			ExpectRequest:    "metax",
+			ExpectConstraint: true,
+		},
+		{
+			Name:          "one topology aware whole gpu",
+			TopologyAware: true,
+			Limits:        corev1.ResourceList{"metax-tech.com/gpu": resource.MustParse("1")},
+			ExpectRequest: "metax",
+		},
+		{
+			Name:          "whole gpus without topology awareness",
+			Limits:        corev1.ResourceList{"metax-tech.com/gpu": resource.MustParse("2")},
+			ExpectRequest: "metax",
+		},
+		{
+			Name: "sgpu memory in Gi",
+			Limits: corev1.ResourceList{
+				"metax-tech.com/sgpu":    resource.MustParse("1"),
+				"metax-tech.com/vmemory": resource.MustParse("4"),
+				"metax-tech.com/vcore":   resource.MustParse("60"),
+			},
+			ExpectRequest: "sgpu",
+			ExpectMemory:  "4Gi",
+		},
+		{
+			Name:          "topology aware sgpus",
+			TopologyAware: true,
+			Limits: corev1.ResourceList{
+				"metax-tech.com/sgpu":    resource.MustParse("2"),
+				"metax-tech.com/vmemory": resource.MustParse("4"),
+			},
+			ExpectRequest:    "sgpu",
+			ExpectMemory:     "4Gi",
+			ExpectConstraint: true,
+		},
+		{
+			Name: "sgpus without topology awareness",
+			Limits: corev1.ResourceList{
+				"metax-tech.com/sgpu":    resource.MustParse("2"),
+				"metax-tech.com/vmemory": resource.MustParse("4"),
+			},
+			ExpectRequest: "sgpu",
+			ExpectMemory:  "4Gi",
+		},
+		{
+			Name: "gpus and sgpus",
+			Limits: corev1.ResourceList{
+				"metax-tech.com/gpu":  resource.MustParse("1"),
+				"metax-tech.com/sgpu": resource.MustParse("1"),
+			},
+			ExpectDenied: true,
+		},
+		{
+			Name: "gpus and sgpu memory",
+			Limits: corev1.ResourceList{
+				"metax-tech.com/gpu":     resource.MustParse("1"),
+				"metax-tech.com/vmemory": resource.MustParse("4"),
+			},
+			ExpectDenied: true,
+		},
+		{
+			Name: "gpus and sgpu cores",
+			Limits: corev1.ResourceList{
+				"metax-tech.com/gpu":   resource.MustParse("1"),
+				"metax-tech.com/vcore": resource.MustParse("50"),
+			},
+			ExpectDenied: true,
+		},
+	}
+
+	for i := range tests {
+		tc := tests[i]
+
+		t.Run(tc.Name, func(t *testing.T) {
+			deviceConfig := &config.Config{Metax: config.MetaxConfig{
+				ResourceCountName:   "metax-tech.com/gpu",
+				ResourceVCountName:  "metax-tech.com/sgpu",
+				ResourceVMemoryName: "metax-tech.com/vmemory",
+				ResourceVCoreName:   "metax-tech.com/vcore",
+				SGPUTopologyAware:   tc.TopologyAware,
+				DRA:                 config.VendorDRAConfig{DriverName: "gpu.metax.example.com"},
+			}}
+			config.SetDefaults(deviceConfig)
+			translator := NewTranslator(&deviceConfig.Metax)
+			container := &corev1.Container{Name: "main", Resources: corev1.ResourceRequirements{Limits: tc.Limits}}
+
+			claim, err := translator.Translate(container, &device.Pod{})
+			var denied *device.DeniedError
+			if tc.ExpectDenied {
+				if !errors.As(err, &denied) {
+					t.Fatalf("Expect denied error, but got: %v", err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("No error is expected but got: %v", err)
+			}
+			request := claim.Requests[0]
+			if request.Name != tc.ExpectRequest {
+				t.Fatalf("expect request: %s, but got: %s", tc.ExpectRequest, request.Name)
+			}
+			if tc.ExpectMemory != "" {
+				if memory := request.Exactly.Capacity.Requests["memory"]; memory.Cmp(resource.MustParse(tc.ExpectMemory)) != 0 {
+					t.Fatalf("expect memory: %s, but got: %s", tc.ExpectMemory, memory.String())
+				}
+			}
+			if tc.ExpectConstraint {
+				if len(claim.Constraints) != 1 || string(*claim.Constraints[0].MatchAttribute) != "gpu.metax.example.com/interconnectDomain" ||
+					claim.Constraints[0].Requests[0] != tc.ExpectRequest {
+					t.Fatalf("expect an interconnect domain constraint, but got: %v", claim.Constraints)
+				}
+			} else if len(claim.Constraints) != 0 {
+				t.Fatalf("expect no constraint, but got: %v", claim.Constraints)
+			}
+			if len(container.Resources.Limits) != 0 {
+				t.Fatalf("expect the resources to be removed, but got: %v", container.Resources.Limits)
+			}
+		})
+	}
+}
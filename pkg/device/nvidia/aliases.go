@@ -0,0 +1,73 @@
+/*
+Copyright 2025 The HAMi Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package nvidia
+
+import (
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+
+	"github.com/Project-HAMi/HAMi-DRA/pkg/device"
+)
+
+// mebibyte is the unit of the NVIDIA memory resource.
+const mebibyte = 1024 * 1024
+
+// resolveAliases renames the aliased GPU resources of the container to the NVIDIA resource names,
+// converting memory to MiB, so that they are translated like the NVIDIA resources.
+func (t *Translator) resolveAliases(container *corev1.Container) error {
+	for _, alias := range t.config.Aliases() {
+		// Memory and cores only have a meaning along with a number of GPUs.
+		if _, ok := container.Resources.Limits[corev1.ResourceName(alias.ResourceCountName)]; !ok {
+			continue
+		}
+		memoryUnit := resource.MustParse(alias.MemoryUnit)
+		for _, names := range []struct {
+			alias     string
+			canonical string
+			unit      *resource.Quantity
+		}{
+			{alias.ResourceCountName, t.config.ResourceCountName, nil},
+			{alias.ResourceMemoryName, t.config.ResourceMemoryName, &memoryUnit},
+			{alias.ResourceCoreName, t.config.ResourceCoreName, nil},
+		} {
+			if names.alias == "" {
+				continue
+			}
+			for _, list := range []corev1.ResourceList{container.Resources.Limits, container.Resources.Requests} {
+				qty, ok := list[corev1.ResourceName(names.alias)]
+				if !ok {
+					continue
+				}
+				if _, ok := list[corev1.ResourceName(names.canonical)]; ok {
+					return device.Deniedf("container %s requests both %s and its alias %s", container.Name, names.canonical, names.alias)
+				}
+				if names.unit != nil {
+					qty = toMebibytes(qty, *names.unit)
+				}
+				list[corev1.ResourceName(names.canonical)] = qty
+				delete(list, corev1.ResourceName(names.alias))
+			}
+		}
+	}
+	return nil
+}
+
+// toMebibytes converts a number of memory units into MiB, rounded up.
+func toMebibytes(qty resource.Quantity, unit resource.Quantity) resource.Quantity {
+	bytes := qty.Value() * unit.Value()
+	return *resource.NewQuantity((bytes+mebibyte-1)/mebibyte, resource.DecimalSI)
+}
@@ -0,0 +1,105 @@
+/*
+Copyright 2025 The HAMi Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package nvidia
+
+import (
+	"testing"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+
+	"github.com/Project-HAMi/HAMi-DRA/pkg/config"
+	"github.com/Project-HAMi/HAMi-DRA/pkg/device"
+)
+
+func TestTranslateContainerAliases(t *testing.T) {
+	tests := []struct {
+		Name         string
+		Limits       corev1.ResourceList
+		ExpectError  bool
+		ExpectCount  int64
+		ExpectMemory int64
+		ExpectCores  int64
+	}{
+		{
+			Name: "volcano resources",
+			Limits: corev1.ResourceList{
+				"volcano.sh/vgpu-number": resource.MustParse("2"),
+				"volcano.sh/vgpu-memory": resource.MustParse("3000"),
+				"volcano.sh/vgpu-cores":  resource.MustParse("50"),
+			},
+			ExpectCount:  2,
+			ExpectMemory: 3000 * mebibyte,
+			ExpectCores:  50,
+		},
+		{
+			Name: "custom alias in GB",
+			Limits: corev1.ResourceList{
+				"example.com/gpu":    resource.MustParse("1"),
+				"example.com/gpu-gb": resource.MustParse("2"),
+			},
+			ExpectCount:  1,
+			ExpectMemory: 1908 * mebibyte,
+		},
+		{
+			Name: "alias and nvidia resource",
+			Limits: corev1.ResourceList{
+				"volcano.sh/vgpu-number": resource.MustParse("1"),
+				"nvidia.com/gpu":         resource.MustParse("1"),
+			},
+			ExpectError: true,
+		},
+	}
+
+	for i := range tests {
+		tc := tests[i]
+
+		t.Run(tc.Name, func(t *testing.T) {
+			translator := NewTranslator(nvidiaConfig(config.NvidiaConfig{
+				ResourceAliasPresets: []string{config.VolcanoAliasPreset},
+				ResourceAliases: []config.ResourceAlias{
+					{Name: "gb", ResourceCountName: "example.com/gpu", ResourceMemoryName: "example.com/gpu-gb", MemoryUnit: "1G"},
+				},
+			}))
+			container := &corev1.Container{Name: "main", Resources: corev1.ResourceRequirements{Limits: tc.Limits}}
+
+			claim, err := translator.Translate(container, &device.Pod{})
+			if tc.ExpectError {
+				if err == nil {
+					t.Fatal("Expect error, but got nil")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("No error is expected but got: %v", err)
+			}
+			exactly := claim.Requests[0].Exactly
+			if exactly.Count != tc.ExpectCount {
+				t.Fatalf("expect count: %d, but got: %d", tc.ExpectCount, exactly.Count)
+			}
+			if memory := exactly.Capacity.Requests["memory"]; memory.Value() != tc.ExpectMemory {
+				t.Fatalf("expect memory: %d, but got: %s", tc.ExpectMemory, memory.String())
+			}
+			if cores := exactly.Capacity.Requests["cores"]; cores.Value() != tc.ExpectCores {
+				t.Fatalf("expect cores: %d, but got: %s", tc.ExpectCores, cores.String())
+			}
+			if len(container.Resources.Limits) != 0 {
+				t.Fatalf("expect the GPU resources to be removed, but got: %v", container.Resources.Limits)
+			}
+		})
+	}
+}
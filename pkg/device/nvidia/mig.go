@@ -0,0 +1,99 @@
+/*
+Copyright 2025 The HAMi Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package nvidia
+
+import (
+	"cmp"
+	"fmt"
+	"slices"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+
+	"github.com/Project-HAMi/HAMi-DRA/pkg/config"
+	"github.com/Project-HAMi/HAMi-DRA/pkg/constants"
+	"github.com/Project-HAMi/HAMi-DRA/pkg/device"
+)
+
+// maxNearestProfiles is the number of MIG profiles suggested when a request fits no profile.
+const maxNearestProfiles = 3
+
+// migProfile is a MIG template of the known geometries, with the most instances a GPU can have of it.
+type migProfile struct {
+	name   string
+	memory int32
+	count  int32
+}
+
+// checkMigGeometries rejects a container in MIG mode whose MIG devices of at least memory MiB are not provided
+// by any template of the allowed geometries of the known models. The devices of a container may come from several GPUs,
+// so only the memory of a device is checked, not how many of them one GPU can hold.
+// A pod pinned to a GPU type is only checked against the geometries of that model, unknown models are not checked.
+func (t *Translator) checkMigGeometries(container *corev1.Container, annotations map[string]string, memory int64) error {
+	if annotations[config.AllocateMode] != config.MigMode || len(t.config.MigGeometriesList) == 0 {
+		return nil
+	}
+
+	allowed := t.config.MigGeometriesList
+	models := "any known model"
+	if deviceType, ok := annotations[constants.UseTypeAnnotation]; ok {
+		// Every entry listing the model contributes its geometries.
+		allowed = slices.DeleteFunc(slices.Clone(allowed), func(geometries config.AllowedMigGeometries) bool {
+			return !slices.Contains(geometries.Models, deviceType)
+		})
+		if len(allowed) == 0 {
+			return nil
+		}
+		models = deviceType
+	}
+
+	profiles := make(map[string]*migProfile)
+	for _, geometries := range allowed {
+		for _, geometry := range geometries.Geometries {
+			for _, template := range geometry {
+				if int64(template.Memory) >= memory {
+					return nil
+				}
+				if profile, ok := profiles[template.Name]; !ok {
+					profiles[template.Name] = &migProfile{name: template.Name, memory: template.Memory, count: template.Count}
+				} else if template.Count > profile.count {
+					profile.count = template.Count
+				}
+			}
+		}
+	}
+
+	return device.Deniedf("container %s requests MIG devices with at least %dMi of memory, but no allowed MIG profile of %s has that much memory; nearest valid profiles: %s",
+		container.Name, memory, models, nearestMigProfiles(profiles, memory))
+}
+
+// nearestMigProfiles formats the profiles whose memory is the closest to memory MiB, which none of them holds.
+func nearestMigProfiles(profiles map[string]*migProfile, memory int64) string {
+	sorted := make([]*migProfile, 0, len(profiles))
+	for _, profile := range profiles {
+		sorted = append(sorted, profile)
+	}
+	slices.SortFunc(sorted, func(a, b *migProfile) int {
+		return cmp.Or(cmp.Compare(b.memory, a.memory), cmp.Compare(b.count, a.count), strings.Compare(a.name, b.name))
+	})
+
+	var nearest []string
+	for _, profile := range sorted[:min(len(sorted), maxNearestProfiles)] {
+		nearest = append(nearest, fmt.Sprintf("%s (%dMi, up to %d per GPU)", profile.name, profile.memory, profile.count))
+	}
+	return strings.Join(nearest, ", ")
+}
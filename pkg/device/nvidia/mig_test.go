@@ -0,0 +1,163 @@
+/*
+Copyright 2025 The HAMi Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package nvidia
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+
+	"github.com/Project-HAMi/HAMi-DRA/pkg/config"
+	"github.com/Project-HAMi/HAMi-DRA/pkg/constants"
+	"github.com/Project-HAMi/HAMi-DRA/pkg/device"
+)
+
+// migGeometries returns the A30 and A100 40GB geometries of the chart.
+func migGeometries() []config.AllowedMigGeometries {
+	return []config.AllowedMigGeometries{
+		{
+			Models: []string{"A30"},
+			Geometries: []config.Geometry{
+				{{Name: "1g.6gb", Core: 25, Memory: 6144, Count: 4}},
+				{{Name: "2g.12gb", Core: 50, Memory: 12288, Count: 2}},
+				{{Name: "4g.24gb", Core: 100, Memory: 24576, Count: 1}},
+			},
+		},
+		{
+			Models: []string{"A100-SXM4-40GB"},
+			Geometries: []config.Geometry{
+				{{Name: "1g.5gb", Core: 14, Memory: 5120, Count: 7}},
+				{{Name: "1g.5gb", Core: 14, Memory: 5120, Count: 1}, {Name: "2g.10gb", Core: 28, Memory: 10240, Count: 3}},
+				{{Name: "7g.40gb", Core: 100, Memory: 40960, Count: 1}},
+			},
+		},
+	}
+}
+
+func TestCheckMigGeometries(t *testing.T) {
+	tests := []struct {
+		Name          string
+		Annotations   map[string]string
+		Geometries    []config.AllowedMigGeometries
+		Count         string
+		Memory        string
+		ExpectDenied  bool
+		ExpectMessage string
+	}{
+		{
+			Name:        "memory of a known profile",
+			Annotations: map[string]string{config.AllocateMode: config.MigMode},
+			Count:       "1",
+			Memory:      "20000",
+		},
+		{
+			Name:        "count of one geometry",
+			Annotations: map[string]string{config.AllocateMode: config.MigMode},
+			Count:       "3",
+			Memory:      "8000",
+		},
+		{
+			Name:          "memory above every profile",
+			Annotations:   map[string]string{config.AllocateMode: config.MigMode},
+			Count:         "1",
+			Memory:        "50000",
+			ExpectDenied:  true,
+			ExpectMessage: "nearest valid profiles: 7g.40gb (40960Mi, up to 1 per GPU), 4g.24gb (24576Mi, up to 1 per GPU), 2g.12gb (12288Mi, up to 2 per GPU)",
+		},
+		{
+			Name:        "more devices than one gpu holds",
+			Annotations: map[string]string{config.AllocateMode: config.MigMode},
+			Count:       "4",
+			Memory:      "8000",
+		},
+		{
+			Name:        "more devices than any geometry holds",
+			Annotations: map[string]string{config.AllocateMode: config.MigMode},
+			Count:       "8",
+			Memory:      "5000",
+		},
+		{
+			Name:          "memory above the pinned model",
+			Annotations:   map[string]string{config.AllocateMode: config.MigMode, constants.UseTypeAnnotation: "A30"},
+			Count:         "1",
+			Memory:        "30000",
+			ExpectDenied:  true,
+			ExpectMessage: "no allowed MIG profile of A30 has that much memory; nearest valid profiles: 4g.24gb (24576Mi, up to 1 per GPU), 2g.12gb",
+		},
+		{
+			Name:        "pinned model listed twice",
+			Annotations: map[string]string{config.AllocateMode: config.MigMode, constants.UseTypeAnnotation: "A30"},
+			Geometries: append(migGeometries(), config.AllowedMigGeometries{
+				Models:     []string{"A30"},
+				Geometries: []config.Geometry{{{Name: "4g.32gb", Core: 100, Memory: 32768, Count: 1}}},
+			}),
+			Count:  "1",
+			Memory: "30000",
+		},
+		{
+			Name:        "memory of the pinned model",
+			Annotations: map[string]string{config.AllocateMode: config.MigMode, constants.UseTypeAnnotation: "A100-SXM4-40GB"},
+			Count:       "1",
+			Memory:      "30000",
+		},
+		{
+			Name:        "unknown pinned model",
+			Annotations: map[string]string{config.AllocateMode: config.MigMode, constants.UseTypeAnnotation: "H100"},
+			Count:       "1",
+			Memory:      "50000",
+		},
+		{
+			Name:   "not in mig mode",
+			Count:  "1",
+			Memory: "50000",
+		},
+	}
+
+	for i := range tests {
+		tc := tests[i]
+
+		t.Run(tc.Name, func(t *testing.T) {
+			geometries := tc.Geometries
+			if geometries == nil {
+				geometries = migGeometries()
+			}
+			translator := NewTranslator(nvidiaConfig(config.NvidiaConfig{MigGeometriesList: geometries}))
+			container := &corev1.Container{Name: "main", Resources: corev1.ResourceRequirements{Limits: corev1.ResourceList{
+				"nvidia.com/gpu":    resource.MustParse(tc.Count),
+				"nvidia.com/gpumem": resource.MustParse(tc.Memory),
+			}}}
+
+			_, err := translator.Translate(container, &device.Pod{Annotations: tc.Annotations})
+			var denied *device.DeniedError
+			if tc.ExpectDenied {
+				if !errors.As(err, &denied) {
+					t.Fatalf("Expect denied error, but got: %v", err)
+				}
+				if !strings.Contains(denied.Message, tc.ExpectMessage) {
+					t.Fatalf("expect message containing %q, but got: %s", tc.ExpectMessage, denied.Message)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("No error is expected but got: %v", err)
+			}
+		})
+	}
+}
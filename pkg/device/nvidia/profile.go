@@ -0,0 +1,61 @@
+/*
+Copyright 2025 The HAMi Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package nvidia
+
+import (
+	"fmt"
+
+	corev1 "k8s.io/api/core/v1"
+	resourceapi "k8s.io/api/resource/v1"
+
+	"github.com/Project-HAMi/HAMi-DRA/pkg/config"
+	"github.com/Project-HAMi/HAMi-DRA/pkg/device"
+)
+
+// translateNvidiaProfile turns the GPU sharing resources of the container into a request of the upstream
+// NVIDIA DRA driver. Pods in MIG mode get MIG devices with at least the requested memory, other pods whole GPUs.
+// GPU cores, and memory outside of MIG mode, cannot be enforced by that driver and are denied.
+func (t *Translator) translateNvidiaProfile(container *corev1.Container, claim *resourceapi.DeviceClaim, annotations map[string]string) error {
+	for _, name := range []string{t.config.ResourceCoreName, t.config.ResourceMemoryPercentageName} {
+		if _, ok := container.Resources.Limits[corev1.ResourceName(name)]; ok && name != "" {
+			return device.Deniedf("container %s requests %s, but the NVIDIA DRA driver %s allocates whole GPUs or MIG devices and cannot limit GPU cores or memory shares; remove %s",
+				container.Name, name, t.config.DRA.DriverName, name)
+		}
+	}
+
+	memoryName := corev1.ResourceName(t.config.ResourceMemoryName)
+	memQty, hasMemory := container.Resources.Limits[memoryName]
+	if annotations[config.AllocateMode] != config.MigMode {
+		if hasMemory {
+			return device.Deniedf("container %s requests %s, but the NVIDIA DRA driver %s allocates whole GPUs and cannot limit GPU memory; remove %s, or annotate the pod with %s: %s to get a MIG device with at least this memory",
+				container.Name, memoryName, t.config.DRA.DriverName, memoryName, config.AllocateMode, config.MigMode)
+		}
+		return nil
+	}
+
+	exactly := claim.Requests[0].Exactly
+	// A count resource mapped to its own DeviceClass keeps it, the class decides which devices it gets.
+	if exactly.DeviceClassName == t.config.DRA.DeviceClassName {
+		exactly.DeviceClassName = t.config.DRA.MigDeviceClassName
+	}
+	if hasMemory {
+		exactly.Selectors = append(exactly.Selectors, device.CELSelector(
+			fmt.Sprintf(`device.capacity["%s"].memory.compareTo(quantity("%dMi")) >= 0`, t.config.DRA.DriverName, memQty.Value())))
+		device.RemoveResource(container, memoryName)
+	}
+	return nil
+}
@@ -0,0 +1,109 @@
+/*
+Copyright 2025 The HAMi Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package nvidia
+
+import (
+	"errors"
+	"testing"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+
+	"github.com/Project-HAMi/HAMi-DRA/pkg/config"
+	"github.com/Project-HAMi/HAMi-DRA/pkg/device"
+)
+
+func TestTranslateNvidiaProfile(t *testing.T) {
+	tests := []struct {
+		Name              string
+		Limits            corev1.ResourceList
+		Annotations       map[string]string
+		ExpectDenied      bool
+		ExpectDeviceClass string
+		ExpectSelectors   int
+	}{
+		{
+			Name:              "whole gpu",
+			Limits:            corev1.ResourceList{"nvidia.com/gpu": resource.MustParse("2")},
+			ExpectDeviceClass: "gpu.nvidia.com",
+			ExpectSelectors:   0,
+		},
+		{
+			Name: "mig device with memory",
+			Limits: corev1.ResourceList{
+				"nvidia.com/gpu":    resource.MustParse("1"),
+				"nvidia.com/gpumem": resource.MustParse("10240"),
+			},
+			Annotations:       map[string]string{config.AllocateMode: config.MigMode},
+			ExpectDeviceClass: "mig.nvidia.com",
+			ExpectSelectors:   1,
+		},
+		{
+			Name: "memory outside of mig mode",
+			Limits: corev1.ResourceList{
+				"nvidia.com/gpu":    resource.MustParse("1"),
+				"nvidia.com/gpumem": resource.MustParse("10240"),
+			},
+			ExpectDenied: true,
+		},
+		{
+			Name: "cores",
+			Limits: corev1.ResourceList{
+				"nvidia.com/gpu":      resource.MustParse("1"),
+				"nvidia.com/gpucores": resource.MustParse("50"),
+			},
+			Annotations:  map[string]string{config.AllocateMode: config.MigMode},
+			ExpectDenied: true,
+		},
+	}
+
+	for i := range tests {
+		tc := tests[i]
+
+		t.Run(tc.Name, func(t *testing.T) {
+			translator := NewTranslator(nvidiaConfig(config.NvidiaConfig{
+				DRA: config.DRAConfig{Profile: config.NvidiaDRAProfile},
+			}))
+			container := &corev1.Container{Name: "main", Resources: corev1.ResourceRequirements{Limits: tc.Limits}}
+
+			claim, err := translator.Translate(container, &device.Pod{Annotations: tc.Annotations})
+			var denied *device.DeniedError
+			if tc.ExpectDenied {
+				if !errors.As(err, &denied) {
+					t.Fatalf("Expect denied error, but got: %v", err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("No error is expected but got: %v", err)
+			}
+			exactly := claim.Requests[0].Exactly
+			if exactly.DeviceClassName != tc.ExpectDeviceClass {
+				t.Fatalf("expect device class: %s, but got: %s", tc.ExpectDeviceClass, exactly.DeviceClassName)
+			}
+			if len(exactly.Selectors) != tc.ExpectSelectors {
+				t.Fatalf("expect %d selectors, but got: %d", tc.ExpectSelectors, len(exactly.Selectors))
+			}
+			if len(exactly.Capacity.Requests) != 0 {
+				t.Fatalf("expect no capacity requests, but got: %v", exactly.Capacity.Requests)
+			}
+			if len(container.Resources.Limits) != 0 {
+				t.Fatalf("expect the GPU resources to be removed, but got: %v", container.Resources.Limits)
+			}
+		})
+	}
+}
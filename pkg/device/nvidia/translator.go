@@ -0,0 +1,272 @@
+/*
+Copyright 2025 The HAMi Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package nvidia translates the NVIDIA GPU resources of HAMi into DRA device requests.
+package nvidia
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+	resourceapi "k8s.io/api/resource/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+
+	policyv1alpha1 "github.com/Project-HAMi/HAMi-DRA/pkg/apis/policy/v1alpha1"
+	"github.com/Project-HAMi/HAMi-DRA/pkg/config"
+	"github.com/Project-HAMi/HAMi-DRA/pkg/constants"
+	"github.com/Project-HAMi/HAMi-DRA/pkg/device"
+)
+
+// Name is the name of the NVIDIA translator.
+const Name = "nvidia"
+
+func init() {
+	device.Register(Name, func(deviceConfig *config.Config) device.DeviceTranslator {
+		return NewTranslator(&deviceConfig.Nvidia)
+	})
+}
+
+// Translator translates the NVIDIA count, memory and core resources, and their aliases.
+type Translator struct {
+	config *config.NvidiaConfig
+}
+
+// Check if our Translator implements necessary interface
+var _ device.DeviceTranslator = &Translator{}
+
+// NewTranslator creates a translator for the NVIDIA section of the device config.
+func NewTranslator(nvidiaConfig *config.NvidiaConfig) *Translator {
+	return &Translator{config: nvidiaConfig}
+}
+
+// Name identifies the translator in logs and errors.
+func (t *Translator) Name() string {
+	return Name
+}
+
+// ResourceNames returns the NVIDIA resource names, the count resources mapped to a DeviceClass and the aliases.
+func (t *Translator) ResourceNames() []corev1.ResourceName {
+	var names []corev1.ResourceName
+	for _, name := range []string{
+		t.config.ResourceCountName,
+		t.config.ResourceMemoryName,
+		t.config.ResourceCoreName,
+		t.config.ResourceMemoryPercentageName,
+	} {
+		if name != "" {
+			names = append(names, corev1.ResourceName(name))
+		}
+	}
+	for _, deviceClass := range t.config.DRA.DeviceClasses {
+		names = append(names, corev1.ResourceName(deviceClass.ResourceName))
+	}
+	for _, alias := range t.config.Aliases() {
+		for _, name := range []string{alias.ResourceCountName, alias.ResourceMemoryName, alias.ResourceCoreName} {
+			if name != "" {
+				names = append(names, corev1.ResourceName(name))
+			}
+		}
+	}
+	return names
+}
+
+// Translate removes the GPU resources from the container and returns the equivalent device request.
+// It returns nil if the container does not request any GPU, and a denied error if the policy rejects the request.
+func (t *Translator) Translate(container *corev1.Container, pod *device.Pod) (*resourceapi.DeviceClaim, error) {
+	annotations, policy := pod.Annotations, pod.Policy
+	if err := t.resolveAliases(container); err != nil {
+		return nil, err
+	}
+	countResourceName, deviceClassName, err := t.countResource(container)
+	if err != nil || countResourceName == "" {
+		return nil, err
+	}
+	countQty := container.Resources.Limits[countResourceName]
+	if err := checkPolicy(policy, countQty.Value(), annotations); err != nil {
+		return nil, err
+	}
+	memQty := container.Resources.Limits[corev1.ResourceName(t.config.ResourceMemoryName)]
+	if err := t.checkMigGeometries(container, annotations, memQty.Value()); err != nil {
+		return nil, err
+	}
+
+	claim := t.buildDeviceClaim(deviceClassName)
+
+	claim.Requests[0].Exactly.Count = countQty.Value()
+
+	// Remove count resource from container
+	device.RemoveResource(container, countResourceName)
+
+	if t.config.DRA.Profile == config.NvidiaDRAProfile {
+		if err := t.translateNvidiaProfile(container, claim, annotations); err != nil {
+			return nil, err
+		}
+	} else {
+		t.requestCapacity(container, claim, policy)
+	}
+
+	t.addAnnotationSelectors(claim, annotations)
+	t.addPolicySelectors(claim, annotations, policy)
+	return claim, nil
+}
+
+// requestCapacity moves the GPU cores and memory of the container into capacity requests of the HAMi driver.
+func (t *Translator) requestCapacity(container *corev1.Container, claim *resourceapi.DeviceClaim, policy *policyv1alpha1.GPUPolicy) {
+	defaultCores, defaultMemory := t.config.DefaultCores, t.config.DefaultMemory
+	if policy != nil && policy.Spec.DefaultCores != nil {
+		defaultCores = *policy.Spec.DefaultCores
+	}
+	if policy != nil && policy.Spec.DefaultMemory != nil {
+		defaultMemory = *policy.Spec.DefaultMemory
+	}
+
+	exactly := claim.Requests[0].Exactly
+	if coreQty, ok := container.Resources.Limits[corev1.ResourceName(t.config.ResourceCoreName)]; ok {
+		exactly.Capacity.Requests["cores"] = coreQty
+		device.RemoveResource(container, corev1.ResourceName(t.config.ResourceCoreName))
+	} else if defaultCores > 0 {
+		exactly.Capacity.Requests["cores"] = *resource.NewQuantity(int64(defaultCores), resource.DecimalSI)
+	}
+	if memQty, ok := container.Resources.Limits[corev1.ResourceName(t.config.ResourceMemoryName)]; ok {
+		mem := resource.MustParse(fmt.Sprintf("%d", memQty.Value()*mebibyte))
+		exactly.Capacity.Requests["memory"] = mem
+		device.RemoveResource(container, corev1.ResourceName(t.config.ResourceMemoryName))
+	} else if defaultMemory > 0 {
+		exactly.Capacity.Requests["memory"] = *resource.NewQuantity(int64(defaultMemory)*1024*1024, resource.DecimalSI)
+	}
+}
+
+// countResource returns the count resource requested by the container and the DeviceClass it maps to.
+// It returns an empty name if the container does not request any GPU.
+func (t *Translator) countResource(container *corev1.Container) (corev1.ResourceName, string, error) {
+	var found corev1.ResourceName
+	var deviceClassName string
+	check := func(resourceName, className string) error {
+		if _, ok := container.Resources.Limits[corev1.ResourceName(resourceName)]; !ok {
+			return nil
+		}
+		if found != "" {
+			return device.Deniedf("container %s requests both %s and %s, only one GPU count resource may be requested", container.Name, found, resourceName)
+		}
+		found, deviceClassName = corev1.ResourceName(resourceName), className
+		return nil
+	}
+
+	if err := check(t.config.ResourceCountName, t.config.DRA.DeviceClassName); err != nil {
+		return "", "", err
+	}
+	for _, deviceClass := range t.config.DRA.DeviceClasses {
+		if err := check(deviceClass.ResourceName, deviceClass.DeviceClassName); err != nil {
+			return "", "", err
+		}
+	}
+	return found, deviceClassName, nil
+}
+
+// buildDeviceClaim creates a device claim requesting the DeviceClass with default selectors.
+func (t *Translator) buildDeviceClaim(deviceClassName string) *resourceapi.DeviceClaim {
+	claim := &resourceapi.DeviceClaim{
+		Requests: []resourceapi.DeviceRequest{
+			{
+				Name: t.config.DRA.RequestName,
+				Exactly: &resourceapi.ExactDeviceRequest{
+					AllocationMode: resourceapi.DeviceAllocationModeExactCount,
+					Capacity: &resourceapi.CapacityRequirements{
+						Requests: make(map[resourceapi.QualifiedName]resource.Quantity),
+					},
+					DeviceClassName: deviceClassName,
+				},
+			},
+		},
+	}
+	if deviceType := t.config.DRA.DeviceType; deviceType != "" {
+		exactly := claim.Requests[0].Exactly
+		exactly.Selectors = append(exactly.Selectors,
+			device.CELSelector(fmt.Sprintf(`%s == %s`, t.attribute(t.config.DRA.Attributes.Type), device.CELString(deviceType))))
+	}
+	return claim
+}
+
+// attribute returns the CEL expression of the named attribute of the configured driver.
+func (t *Translator) attribute(name string) string {
+	return device.Attribute(t.config.DRA.DriverName, name)
+}
+
+// addAnnotationSelectors adds device selectors based on pod annotations.
+func (t *Translator) addAnnotationSelectors(claim *resourceapi.DeviceClaim, annotations map[string]string) {
+	exactly := claim.Requests[0].Exactly
+
+	if uuid, ok := annotations[constants.UseUUIDAnnotation]; ok {
+		exactly.Selectors = append(exactly.Selectors,
+			device.CELSelector(fmt.Sprintf(`%s == %s`, t.attribute(t.config.DRA.Attributes.UUID), device.CELString(uuid))))
+	}
+
+	if deviceType, ok := annotations[constants.UseTypeAnnotation]; ok {
+		exactly.Selectors = append(exactly.Selectors,
+			device.CELSelector(fmt.Sprintf(`%s == %s`, t.attribute(t.config.DRA.Attributes.ProductName), device.CELString(deviceType))))
+	}
+}
+
+// addPolicySelectors restricts the devices to those allowed by the policy, unless the pod already pins them.
+func (t *Translator) addPolicySelectors(claim *resourceapi.DeviceClaim, annotations map[string]string, policy *policyv1alpha1.GPUPolicy) {
+	if policy == nil {
+		return
+	}
+	exactly := claim.Requests[0].Exactly
+
+	if _, ok := annotations[constants.UseUUIDAnnotation]; !ok && len(policy.Spec.AllowedUUIDs) > 0 {
+		exactly.Selectors = append(exactly.Selectors,
+			device.CELSelector(fmt.Sprintf(`%s in %s`, t.attribute(t.config.DRA.Attributes.UUID), celStringList(policy.Spec.AllowedUUIDs))))
+	}
+
+	if _, ok := annotations[constants.UseTypeAnnotation]; !ok && len(policy.Spec.AllowedProductNames) > 0 {
+		exactly.Selectors = append(exactly.Selectors,
+			device.CELSelector(fmt.Sprintf(`%s in %s`, t.attribute(t.config.DRA.Attributes.ProductName), celStringList(policy.Spec.AllowedProductNames))))
+	}
+}
+
+// checkPolicy rejects a container requesting count GPUs whose pod does not follow the policy.
+func checkPolicy(policy *policyv1alpha1.GPUPolicy, count int64, annotations map[string]string) error {
+	if policy == nil {
+		return nil
+	}
+	if max := policy.Spec.MaxGPUsPerContainer; max != nil && count > int64(*max) {
+		return device.Deniedf("GPUPolicy %s allows at most %d GPUs per container, but %d are requested", policy.Name, *max, count)
+	}
+	if mode, ok := annotations[config.AllocateMode]; ok && policy.Spec.VGPUMode != "" && mode != policy.Spec.VGPUMode {
+		return device.Deniedf("GPUPolicy %s requires %s %q, but the pod asks for %q", policy.Name, config.AllocateMode, policy.Spec.VGPUMode, mode)
+	}
+	if deviceType, ok := annotations[constants.UseTypeAnnotation]; ok && len(policy.Spec.AllowedProductNames) > 0 &&
+		!slices.Contains(policy.Spec.AllowedProductNames, deviceType) {
+		return device.Deniedf("GPUPolicy %s does not allow GPU type %q, allowed types: %s", policy.Name, deviceType, strings.Join(policy.Spec.AllowedProductNames, ", "))
+	}
+	if uuid, ok := annotations[constants.UseUUIDAnnotation]; ok && len(policy.Spec.AllowedUUIDs) > 0 &&
+		!slices.Contains(policy.Spec.AllowedUUIDs, uuid) {
+		return device.Deniedf("GPUPolicy %s does not allow GPU %q", policy.Name, uuid)
+	}
+	return nil
+}
+
+// celStringList formats values as a CEL list literal.
+func celStringList(values []string) string {
+	quoted := make([]string, 0, len(values))
+	for _, value := range values {
+		quoted = append(quoted, device.CELString(value))
+	}
+	return "[" + strings.Join(quoted, ", ") + "]"
+}
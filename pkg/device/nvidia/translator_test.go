@@ -0,0 +1,178 @@
+/*
+Copyright 2025 The HAMi Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package nvidia
+
+import (
+	"slices"
+	"testing"
+
+	corev1 "k8s.io/api/core/v1"
+	resourceapi "k8s.io/api/resource/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+
+	"github.com/Project-HAMi/HAMi-DRA/pkg/config"
+	"github.com/Project-HAMi/HAMi-DRA/pkg/constants"
+	"github.com/Project-HAMi/HAMi-DRA/pkg/device"
+)
+
+// nvidiaConfig returns the NVIDIA section with the defaults the webhook loads it with.
+func nvidiaConfig(nvidia config.NvidiaConfig) *config.NvidiaConfig {
+	deviceConfig := &config.Config{Nvidia: nvidia}
+	config.SetDefaults(deviceConfig)
+	return &deviceConfig.Nvidia
+}
+
+func TestTranslateDeviceClasses(t *testing.T) {
+	tests := []struct {
+		Name              string
+		Limits            corev1.ResourceList
+		ExpectError       bool
+		ExpectDeviceClass string
+		ExpectSelector    string
+	}{
+		{
+			Name:              "default count resource",
+			Limits:            corev1.ResourceList{"nvidia.com/gpu": resource.MustParse("1")},
+			ExpectDeviceClass: "gpu.example.com",
+			ExpectSelector:    `device.attributes["gpu.example.com"].kind == "vgpu"`,
+		},
+		{
+			Name:              "mapped count resource",
+			Limits:            corev1.ResourceList{"hami.io/a100": resource.MustParse("1")},
+			ExpectDeviceClass: "a100.gpu.example.com",
+			ExpectSelector:    `device.attributes["gpu.example.com"].kind == "vgpu"`,
+		},
+		{
+			Name: "two count resources",
+			Limits: corev1.ResourceList{
+				"nvidia.com/gpu": resource.MustParse("1"),
+				"hami.io/a100":   resource.MustParse("1"),
+			},
+			ExpectError: true,
+		},
+	}
+
+	for i := range tests {
+		tc := tests[i]
+
+		t.Run(tc.Name, func(t *testing.T) {
+			translator := NewTranslator(nvidiaConfig(config.NvidiaConfig{
+				DRA: config.DRAConfig{
+					DriverName:  "gpu.example.com",
+					DeviceType:  "vgpu",
+					RequestName: "accelerator",
+					Attributes:  config.DRAAttributes{Type: "kind"},
+					DeviceClasses: []config.ResourceDeviceClass{
+						{ResourceName: "hami.io/a100", DeviceClassName: "a100.gpu.example.com"},
+					},
+				},
+			}))
+			container := &corev1.Container{Name: "main", Resources: corev1.ResourceRequirements{Limits: tc.Limits}}
+
+			claim, err := translator.Translate(container, &device.Pod{})
+			if tc.ExpectError {
+				if err == nil {
+					t.Fatal("Expect error, but got nil")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("No error is expected but got: %v", err)
+			}
+			request := claim.Requests[0]
+			if request.Name != "accelerator" {
+				t.Fatalf("expect request name: accelerator, but got: %s", request.Name)
+			}
+			if request.Exactly.DeviceClassName != tc.ExpectDeviceClass {
+				t.Fatalf("expect device class: %s, but got: %s", tc.ExpectDeviceClass, request.Exactly.DeviceClassName)
+			}
+			if selector := request.Exactly.Selectors[0].CEL.Expression; selector != tc.ExpectSelector {
+				t.Fatalf("expect selector: %s, but got: %s", tc.ExpectSelector, selector)
+			}
+			if len(container.Resources.Limits) != 0 {
+				t.Fatalf("expect the count resource to be removed, but got: %v", container.Resources.Limits)
+			}
+		})
+	}
+}
+
+func TestResourceNames(t *testing.T) {
+	translator := NewTranslator(nvidiaConfig(config.NvidiaConfig{
+		ResourceAliasPresets: []string{config.VolcanoAliasPreset},
+		DRA: config.DRAConfig{
+			DeviceClasses: []config.ResourceDeviceClass{
+				{ResourceName: "hami.io/a100", DeviceClassName: "a100.hami-core-gpu.project-hami.io"},
+			},
+		},
+	}))
+	names := translator.ResourceNames()
+	for _, name := range []corev1.ResourceName{"nvidia.com/gpu", "nvidia.com/gpumem", "hami.io/a100", "volcano.sh/vgpu-memory"} {
+		if !slices.Contains(names, name) {
+			t.Errorf("expect resource name %s, but got: %v", name, names)
+		}
+	}
+}
+
+func TestAddAnnotationSelectors(t *testing.T) {
+	tests := []struct {
+		Name            string
+		Annotations     map[string]string
+		ExpectSelectors []string
+	}{
+		{
+			Name:            "gpu type",
+			Annotations:     map[string]string{constants.UseTypeAnnotation: "A100"},
+			ExpectSelectors: []string{`device.attributes["gpu.example.com"].productName == "A100"`},
+		},
+		{
+			Name:            "gpu uuid",
+			Annotations:     map[string]string{constants.UseUUIDAnnotation: "GPU-0"},
+			ExpectSelectors: []string{`device.attributes["gpu.example.com"].uuid == "GPU-0"`},
+		},
+		{
+			Name: "values with quotes",
+			Annotations: map[string]string{
+				constants.UseUUIDAnnotation: `GPU-0" || true || "`,
+				constants.UseTypeAnnotation: `A100\`,
+			},
+			ExpectSelectors: []string{
+				`device.attributes["gpu.example.com"].uuid == "GPU-0\" || true || \""`,
+				`device.attributes["gpu.example.com"].productName == "A100\\"`,
+			},
+		},
+	}
+
+	translator := NewTranslator(nvidiaConfig(config.NvidiaConfig{DRA: config.DRAConfig{DriverName: "gpu.example.com"}}))
+	for i := range tests {
+		tc := tests[i]
+
+		t.Run(tc.Name, func(t *testing.T) {
+			claim := &resourceapi.DeviceClaim{Requests: []resourceapi.DeviceRequest{{Exactly: &resourceapi.ExactDeviceRequest{}}}}
+			translator.addAnnotationSelectors(claim, tc.Annotations)
+
+			selectors := claim.Requests[0].Exactly.Selectors
+			if len(selectors) != len(tc.ExpectSelectors) {
+				t.Fatalf("expect selectors: %v, but got: %v", tc.ExpectSelectors, selectors)
+			}
+			for j, selector := range selectors {
+				if selector.CEL.Expression != tc.ExpectSelectors[j] {
+					t.Fatalf("expect selector: %s, but got: %s", tc.ExpectSelectors[j], selector.CEL.Expression)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,85 @@
+/*
+Copyright 2025 The HAMi Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package device defines the translators turning the extended resources of accelerator vendors into DRA device requests.
+package device
+
+import (
+	"fmt"
+	"sort"
+
+	corev1 "k8s.io/api/core/v1"
+	resourceapi "k8s.io/api/resource/v1"
+
+	policyv1alpha1 "github.com/Project-HAMi/HAMi-DRA/pkg/apis/policy/v1alpha1"
+	"github.com/Project-HAMi/HAMi-DRA/pkg/config"
+)
+
+// DeviceTranslator translates the extended resources of one vendor into DRA device requests.
+type DeviceTranslator interface {
+	// Name identifies the translator in logs and errors.
+	Name() string
+	// ResourceNames returns the extended resource names owned by the translator.
+	ResourceNames() []corev1.ResourceName
+	// Translate removes the owned resources from the container and returns the device requests, constraints
+	// and configs replacing them. It returns nil if the container does not request any owned resource,
+	// and a DeniedError if the request cannot be translated.
+	Translate(container *corev1.Container, pod *Pod) (*resourceapi.DeviceClaim, error)
+}
+
+// Pod is what a translation depends on besides the container.
+type Pod struct {
+	// Annotations are the annotations of the pod, with the defaults of its GPUPolicy applied.
+	Annotations map[string]string
+	// Policy is the GPUPolicy of the pod's namespace, nil if it has none.
+	Policy *policyv1alpha1.GPUPolicy
+}
+
+// Factory creates the translator of a vendor from the device config.
+// It returns nil if the vendor is not configured.
+type Factory func(deviceConfig *config.Config) DeviceTranslator
+
+var factories = make(map[string]Factory)
+
+// Register makes the translator factory available under the given name, it is meant to be called from init functions.
+func Register(name string, factory Factory) {
+	if _, ok := factories[name]; ok {
+		panic(fmt.Sprintf("device translator %q is already registered", name))
+	}
+	factories[name] = factory
+}
+
+// Names returns the names of the registered translators, in order.
+func Names() []string {
+	names := make([]string, 0, len(factories))
+	for name := range factories {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// NewTranslators creates the translators of the vendors configured in the device config, ordered by name.
+func NewTranslators(deviceConfig *config.Config) []DeviceTranslator {
+	names := Names()
+	translators := make([]DeviceTranslator, 0, len(names))
+	for _, name := range names {
+		if translator := factories[name](deviceConfig); translator != nil {
+			translators = append(translators, translator)
+		}
+	}
+	return translators
+}
@@ -0,0 +1,59 @@
+/*
+Copyright 2025 The HAMi Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package device
+
+import (
+	"testing"
+
+	corev1 "k8s.io/api/core/v1"
+	resourceapi "k8s.io/api/resource/v1"
+
+	"github.com/Project-HAMi/HAMi-DRA/pkg/config"
+)
+
+type fakeTranslator struct {
+	name string
+}
+
+func (f *fakeTranslator) Name() string { return f.name }
+
+func (f *fakeTranslator) ResourceNames() []corev1.ResourceName { return nil }
+
+func (f *fakeTranslator) Translate(*corev1.Container, *Pod) (*resourceapi.DeviceClaim, error) {
+	return nil, nil
+}
+
+func TestNewTranslators(t *testing.T) {
+	Register("test-b", func(*config.Config) DeviceTranslator { return &fakeTranslator{name: "test-b"} })
+	Register("test-a", func(*config.Config) DeviceTranslator { return &fakeTranslator{name: "test-a"} })
+	Register("test-disabled", func(*config.Config) DeviceTranslator { return nil })
+
+	var names []string
+	for _, translator := range NewTranslators(&config.Config{}) {
+		names = append(names, translator.Name())
+	}
+	if len(names) != 2 || names[0] != "test-a" || names[1] != "test-b" {
+		t.Fatalf("expect translators: [test-a test-b], but got: %v", names)
+	}
+
+	defer func() {
+		if recover() == nil {
+			t.Fatalf("expect registering a translator twice to panic")
+		}
+	}()
+	Register("test-a", func(*config.Config) DeviceTranslator { return nil })
+}
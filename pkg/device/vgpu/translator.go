@@ -0,0 +1,132 @@
+/*
+Copyright 2025 The HAMi Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package vgpu translates the count, memory and core resources of the vendors sharing devices like HAMi does for NVIDIA.
+package vgpu
+
+import (
+	corev1 "k8s.io/api/core/v1"
+	resourceapi "k8s.io/api/resource/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+
+	"github.com/Project-HAMi/HAMi-DRA/pkg/config"
+	"github.com/Project-HAMi/HAMi-DRA/pkg/device"
+)
+
+// Spec describes the resources of a vendor and how they are requested from its DRA driver.
+type Spec struct {
+	// Name identifies the vendor, it is also the name of its translator.
+	Name string
+	// RequestName is the name of the device request in the claims.
+	RequestName        string
+	ResourceCountName  string
+	ResourceMemoryName string
+	ResourceCoreName   string
+	// MemoryUnit is the quantity of one unit of the memory resource.
+	MemoryUnit string
+	// MaxCores is the largest core request of a device, unchecked if 0.
+	MaxCores int64
+	DRA      config.VendorDRAConfig
+}
+
+// Translator translates the resources of one vendor into requests of consumable capacity:
+// the memory in bytes and the cores in the unit of the vendor.
+type Translator struct {
+	spec       Spec
+	memoryUnit resource.Quantity
+}
+
+// Check if our Translator implements necessary interface
+var _ device.DeviceTranslator = &Translator{}
+
+// NewTranslator creates a translator for the vendor, or returns nil if its DRA driver is not configured.
+// The spec must have been validated.
+func NewTranslator(spec Spec) *Translator {
+	if !spec.DRA.Enabled() || spec.ResourceCountName == "" {
+		return nil
+	}
+	return &Translator{spec: spec, memoryUnit: resource.MustParse(spec.MemoryUnit)}
+}
+
+// register makes the translator of a vendor available, spec returns the vendor spec from the device config.
+func register(name string, spec func(deviceConfig *config.Config) Spec) {
+	device.Register(name, func(deviceConfig *config.Config) device.DeviceTranslator {
+		if translator := NewTranslator(spec(deviceConfig)); translator != nil {
+			return translator
+		}
+		return nil
+	})
+}
+
+// Name identifies the translator in logs and errors.
+func (t *Translator) Name() string {
+	return t.spec.Name
+}
+
+// ResourceNames returns the count, memory and core resources of the vendor.
+func (t *Translator) ResourceNames() []corev1.ResourceName {
+	var names []corev1.ResourceName
+	for _, name := range []string{t.spec.ResourceCountName, t.spec.ResourceMemoryName, t.spec.ResourceCoreName} {
+		if name != "" {
+			names = append(names, corev1.ResourceName(name))
+		}
+	}
+	return names
+}
+
+// Translate removes the resources of the vendor from the container and returns the equivalent device request.
+// Memory and cores without a number of devices are denied, like cores above the capacity of a device.
+func (t *Translator) Translate(container *corev1.Container, _ *device.Pod) (*resourceapi.DeviceClaim, error) {
+	countName := corev1.ResourceName(t.spec.ResourceCountName)
+	memoryName := corev1.ResourceName(t.spec.ResourceMemoryName)
+	coreName := corev1.ResourceName(t.spec.ResourceCoreName)
+
+	countQty, hasCount := container.Resources.Limits[countName]
+	memQty, hasMemory := container.Resources.Limits[memoryName]
+	coreQty, hasCores := container.Resources.Limits[coreName]
+	if !hasCount {
+		if hasMemory || hasCores {
+			return nil, device.Deniedf("container %s requests %s resources without %s", container.Name, t.spec.Name, countName)
+		}
+		return nil, nil
+	}
+
+	exactly := &resourceapi.ExactDeviceRequest{
+		DeviceClassName: t.spec.DRA.DeviceClassName,
+		AllocationMode:  resourceapi.DeviceAllocationModeExactCount,
+		Count:           countQty.Value(),
+	}
+	capacity := make(map[resourceapi.QualifiedName]resource.Quantity)
+	if hasMemory {
+		capacity["memory"] = *resource.NewQuantity(memQty.Value()*t.memoryUnit.Value(), resource.BinarySI)
+		device.RemoveResource(container, memoryName)
+	}
+	if hasCores {
+		if t.spec.MaxCores > 0 && coreQty.Value() > t.spec.MaxCores {
+			return nil, device.Deniedf("container %s requests %d %s, but a device provides at most %d", container.Name, coreQty.Value(), coreName, t.spec.MaxCores)
+		}
+		capacity["cores"] = *resource.NewQuantity(coreQty.Value(), resource.DecimalSI)
+		device.RemoveResource(container, coreName)
+	}
+	if len(capacity) > 0 {
+		exactly.Capacity = &resourceapi.CapacityRequirements{Requests: capacity}
+	}
+	device.RemoveResource(container, countName)
+
+	return &resourceapi.DeviceClaim{
+		Requests: []resourceapi.DeviceRequest{{Name: t.spec.RequestName, Exactly: exactly}},
+	}, nil
+}
@@ -0,0 +1,182 @@
+/*
+Copyright 2025 The HAMi Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vgpu
+
+import (
+	"errors"
+	"testing"
+
+	corev1 "k8s.io/api/core/v1"
+	resourceapi "k8s.io/api/resource/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+
+	"github.com/Project-HAMi/HAMi-DRA/pkg/config"
+	"github.com/Project-HAMi/HAMi-DRA/pkg/device"
+)
+
+// deviceConfig returns the vendor sections of the chart with their DRA drivers set, and the defaults applied.
+func deviceConfig() *config.Config {
+	deviceConfig := &config.Config{
+		Cambricon: config.CambriconConfig{
+			ResourceCountName:  "cambricon.com/vmlu",
+			ResourceMemoryName: "cambricon.com/mlu.smlu.vmemory",
+			ResourceCoreName:   "cambricon.com/mlu.smlu.vcore",
+			DRA:                config.VendorDRAConfig{DriverName: "mlu.example.com"},
+		},
+		Hygon: config.HygonConfig{
+			ResourceCountName:  "hygon.com/dcunum",
+			ResourceMemoryName: "hygon.com/dcumem",
+			ResourceCoreName:   "hygon.com/dcucores",
+			DRA:                config.VendorDRAConfig{DriverName: "dcu.example.com"},
+		},
+		Mthreads: config.MthreadsConfig{
+			ResourceCountName:  "mthreads.com/vgpu",
+			ResourceMemoryName: "mthreads.com/sgpu-memory",
+			ResourceCoreName:   "mthreads.com/sgpu-core",
+			DRA:                config.VendorDRAConfig{DriverName: "gpu.mthreads.example.com"},
+		},
+	}
+	config.SetDefaults(deviceConfig)
+	return deviceConfig
+}
+
+func TestTranslate(t *testing.T) {
+	tests := []struct {
+		Name              string
+		Limits            corev1.ResourceList
+		ExpectDenied      bool
+		ExpectRequest     string
+		ExpectDeviceClass string
+		ExpectCount       int64
+		ExpectMemory      string
+		ExpectCores       string
+	}{
+		{
+			Name: "cambricon memory in 256Mi",
+			Limits: corev1.ResourceList{
+				"cambricon.com/vmlu":             resource.MustParse("1"),
+				"cambricon.com/mlu.smlu.vmemory": resource.MustParse("20"),
+				"cambricon.com/mlu.smlu.vcore":   resource.MustParse("10"),
+			},
+			ExpectRequest:     "mlu",
+			ExpectDeviceClass: "mlu.example.com",
+			ExpectCount:       1,
+			ExpectMemory:      "5Gi",
+			ExpectCores:       "10",
+		},
+		{
+			Name: "hygon memory in Mi",
+			Limits: corev1.ResourceList{
+				"hygon.com/dcunum": resource.MustParse("2"),
+				"hygon.com/dcumem": resource.MustParse("2000"),
+			},
+			ExpectRequest:     "dcu",
+			ExpectDeviceClass: "dcu.example.com",
+			ExpectCount:       2,
+			ExpectMemory:      "2000Mi",
+		},
+		{
+			Name: "mthreads memory in 512Mi",
+			Limits: corev1.ResourceList{
+				"mthreads.com/vgpu":        resource.MustParse("1"),
+				"mthreads.com/sgpu-memory": resource.MustParse("8"),
+				"mthreads.com/sgpu-core":   resource.MustParse("4"),
+			},
+			ExpectRequest:     "mtgpu",
+			ExpectDeviceClass: "gpu.mthreads.example.com",
+			ExpectCount:       1,
+			ExpectMemory:      "4Gi",
+			ExpectCores:       "4",
+		},
+		{
+			Name: "cores above a device",
+			Limits: corev1.ResourceList{
+				"hygon.com/dcunum":   resource.MustParse("1"),
+				"hygon.com/dcucores": resource.MustParse("150"),
+			},
+			ExpectDenied: true,
+		},
+		{
+			Name:         "memory without devices",
+			Limits:       corev1.ResourceList{"cambricon.com/mlu.smlu.vmemory": resource.MustParse("20")},
+			ExpectDenied: true,
+		},
+	}
+
+	translators := device.NewTranslators(deviceConfig())
+	for i := range tests {
+		tc := tests[i]
+
+		t.Run(tc.Name, func(t *testing.T) {
+			container := &corev1.Container{Name: "main", Resources: corev1.ResourceRequirements{Limits: tc.Limits}}
+
+			var claims []*resourceapi.DeviceClaim
+			for _, translator := range translators {
+				claim, err := translator.Translate(container, &device.Pod{})
+				var denied *device.DeniedError
+				if tc.ExpectDenied {
+					if errors.As(err, &denied) {
+						return
+					}
+					continue
+				}
+				if err != nil {
+					t.Fatalf("No error is expected but got: %v", err)
+				}
+				if claim != nil {
+					claims = append(claims, claim)
+				}
+			}
+			if tc.ExpectDenied {
+				t.Fatalf("Expect denied error, but got nil")
+			}
+			if len(claims) != 1 {
+				t.Fatalf("expect one claim, but got: %v", claims)
+			}
+			request := claims[0].Requests[0]
+			if request.Name != tc.ExpectRequest || request.Exactly.DeviceClassName != tc.ExpectDeviceClass || request.Exactly.Count != tc.ExpectCount {
+				t.Fatalf("expect %d devices of %s in request %s, but got: %d devices of %s in request %s",
+					tc.ExpectCount, tc.ExpectDeviceClass, tc.ExpectRequest, request.Exactly.Count, request.Exactly.DeviceClassName, request.Name)
+			}
+			for name, expect := range map[resourceapi.QualifiedName]string{"memory": tc.ExpectMemory, "cores": tc.ExpectCores} {
+				qty, ok := request.Exactly.Capacity.Requests[name]
+				if expect == "" {
+					if ok {
+						t.Fatalf("expect no %s capacity, but got: %s", name, qty.String())
+					}
+					continue
+				}
+				if expectQty := resource.MustParse(expect); qty.Cmp(expectQty) != 0 {
+					t.Fatalf("expect %s capacity: %s, but got: %s", name, expect, qty.String())
+				}
+			}
+			if len(container.Resources.Limits) != 0 {
+				t.Fatalf("expect the resources to be removed, but got: %v", container.Resources.Limits)
+			}
+		})
+	}
+}
+
+func TestNewTranslatorDisabled(t *testing.T) {
+	deviceConfig := deviceConfig()
+	deviceConfig.Hygon.DRA = config.VendorDRAConfig{}
+	for _, translator := range device.NewTranslators(deviceConfig) {
+		if translator.Name() == HygonName {
+			t.Fatalf("expect no %s translator without a DRA driver", HygonName)
+		}
+	}
+}
@@ -0,0 +1,68 @@
+/*
+Copyright 2025 The HAMi Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vgpu
+
+import (
+	"github.com/Project-HAMi/HAMi-DRA/pkg/config"
+)
+
+// Names of the vendor translators.
+const (
+	CambriconName = "cambricon"
+	HygonName     = "hygon"
+	MthreadsName  = "mthreads"
+)
+
+func init() {
+	register(CambriconName, func(deviceConfig *config.Config) Spec {
+		return Spec{
+			Name:               CambriconName,
+			RequestName:        "mlu",
+			ResourceCountName:  deviceConfig.Cambricon.ResourceCountName,
+			ResourceMemoryName: deviceConfig.Cambricon.ResourceMemoryName,
+			ResourceCoreName:   deviceConfig.Cambricon.ResourceCoreName,
+			MemoryUnit:         deviceConfig.Cambricon.MemoryUnit,
+			// smlu.vcore is a percentage of an MLU.
+			MaxCores: 100,
+			DRA:      deviceConfig.Cambricon.DRA,
+		}
+	})
+	register(HygonName, func(deviceConfig *config.Config) Spec {
+		return Spec{
+			Name:               HygonName,
+			RequestName:        "dcu",
+			ResourceCountName:  deviceConfig.Hygon.ResourceCountName,
+			ResourceMemoryName: deviceConfig.Hygon.ResourceMemoryName,
+			ResourceCoreName:   deviceConfig.Hygon.ResourceCoreName,
+			MemoryUnit:         deviceConfig.Hygon.MemoryUnit,
+			// dcucores is a percentage of a DCU.
+			MaxCores: 100,
+			DRA:      deviceConfig.Hygon.DRA,
+		}
+	})
+	register(MthreadsName, func(deviceConfig *config.Config) Spec {
+		return Spec{
+			Name:               MthreadsName,
+			RequestName:        "mtgpu",
+			ResourceCountName:  deviceConfig.Mthreads.ResourceCountName,
+			ResourceMemoryName: deviceConfig.Mthreads.ResourceMemoryName,
+			ResourceCoreName:   deviceConfig.Mthreads.ResourceCoreName,
+			MemoryUnit:         deviceConfig.Mthreads.MemoryUnit,
+			DRA:                deviceConfig.Mthreads.DRA,
+		}
+	})
+}
@@ -0,0 +1,60 @@
+/*
+Copyright 2025 The HAMi Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package metrics
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	crmetrics "sigs.k8s.io/controller-runtime/pkg/metrics"
+)
+
+const (
+	namespace = "hami_dra_webhook"
+
+	// ResultSuccess and ResultFailure are the values of the result label.
+	ResultSuccess = "success"
+	ResultFailure = "failure"
+)
+
+var (
+	// DeviceConfigReloads counts the attempts to reload the device config file by result.
+	DeviceConfigReloads = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "device_config_reloads_total",
+		Help:      "Number of device config reload attempts, partitioned by result.",
+	}, []string{"result"})
+
+	// DeviceConfigLastReloadSuccess is the last time the device config was successfully reloaded.
+	DeviceConfigLastReloadSuccess = prometheus.NewGauge(prometheus.GaugeOpts{
+		Namespace: namespace,
+		Name:      "device_config_last_reload_success_timestamp_seconds",
+		Help:      "Timestamp of the last successful device config reload.",
+	})
+)
+
+func init() {
+	crmetrics.Registry.MustRegister(DeviceConfigReloads, DeviceConfigLastReloadSuccess)
+}
+
+// RecordDeviceConfigReload records the result of a device config reload attempt.
+func RecordDeviceConfigReload(err error) {
+	if err != nil {
+		DeviceConfigReloads.WithLabelValues(ResultFailure).Inc()
+		return
+	}
+	DeviceConfigReloads.WithLabelValues(ResultSuccess).Inc()
+	DeviceConfigLastReloadSuccess.SetToCurrentTime()
+}
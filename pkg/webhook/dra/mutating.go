@@ -19,26 +19,51 @@ package dra
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"net/http"
+	"slices"
 
 	corev1 "k8s.io/api/core/v1"
 	resourceapi "k8s.io/api/resource/v1"
-	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/klog/v2"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
 
+	policyv1alpha1 "github.com/Project-HAMi/HAMi-DRA/pkg/apis/policy/v1alpha1"
 	"github.com/Project-HAMi/HAMi-DRA/pkg/config"
 	"github.com/Project-HAMi/HAMi-DRA/pkg/constants"
+	"github.com/Project-HAMi/HAMi-DRA/pkg/device"
 )
 
+// ownedAnnotations are the pod annotations translated into ResourceClaim selectors.
+var ownedAnnotations = []string{
+	constants.UseUUIDAnnotation,
+	constants.UseTypeAnnotation,
+	constants.AMDUseTypeAnnotation,
+	constants.SharedGPUClaimAnnotation,
+}
+
+// ownedLabels are the pod labels set by the webhook when translating a pod.
+var ownedLabels = []string{
+	constants.DraLabel,
+	constants.SharedGPUClaimLabel,
+}
+
 // MutatingAdmission mutates API request if necessary.
 type MutatingAdmission struct {
-	Decoder      admission.Decoder
-	Client       client.Client
-	DeviceConfig *config.NvidiaConfig
+	Decoder admission.Decoder
+	Client  client.Client
+	// APIReader, if set, reads the objects the Client cache may not have seen yet, such as a shared claim just created by another pod.
+	APIReader    client.Reader
+	DeviceConfig *config.Config
+	// AdmissionConfig, if set, selects the namespaces whose pods are translated.
+	AdmissionConfig *config.AdmissionConfig
+	// Rules customize the ResourceClaims generated for matching pods, they must have been compiled by config.CompileRules.
+	Rules []config.MutationRule
+	// ConfigStore, if set, provides the DeviceConfig, AdmissionConfig and Rules of every request so that config reloads take effect.
+	ConfigStore *config.Store
 }
 
 // Check if our MutatingAdmission implements necessary interface
@@ -46,6 +71,21 @@ var _ admission.Handler = &MutatingAdmission{}
 
 // Handle yields a response to an AdmissionRequest.
 func (a *MutatingAdmission) Handle(ctx context.Context, req admission.Request) admission.Response {
+	if a.ConfigStore != nil {
+		// Handle the request on a copy bound to the current config, a reload never changes it mid-request.
+		snapshot := *a
+		current := a.ConfigStore.Load()
+		snapshot.DeviceConfig = current
+		snapshot.AdmissionConfig = &current.Admission
+		snapshot.Rules = current.Rules
+		snapshot.ConfigStore = nil
+		return snapshot.Handle(ctx, req)
+	}
+
+	if req.Kind.Kind != "Pod" {
+		return a.handleWorkload(ctx, req)
+	}
+
 	pod := &corev1.Pod{}
 	err := a.Decoder.Decode(req, pod)
 	if err != nil {
@@ -56,29 +96,87 @@ func (a *MutatingAdmission) Handle(ctx context.Context, req admission.Request) a
 	needPatch := false
 	rcNameList := []string{}
 
+	reason, err := a.skipReason(ctx, req.Namespace, pod.Annotations)
+	if err != nil {
+		return admission.Errored(http.StatusInternalServerError, err)
+	}
+	if reason != "" {
+		klog.V(5).Infof("Skip mutating Pod(%s/%s): %s", req.Namespace, pod.Name, reason)
+		return admission.Allowed(reason)
+	}
+
+	translation, err := a.newPodTranslation(ctx, req.Namespace, &pod.ObjectMeta, &pod.Spec)
+	if err != nil {
+		return admission.Errored(http.StatusInternalServerError, err)
+	}
+
+	groups, err := sharedGPUGroups(pod)
+	if err != nil {
+		return admission.Denied(err.Error())
+	}
+	if name, ok := pod.Annotations[constants.SharedGPUClaimAnnotation]; ok {
+		if len(groups) > 0 {
+			return admission.Denied(fmt.Sprintf("annotation %s cannot be combined with shared GPU groups", constants.SharedGPUClaimAnnotation))
+		}
+		return a.handleSharedClaimPod(ctx, req, pod, translation, name)
+	}
+	groupSpecs := make(map[string][]*resourceapi.ResourceClaimSpec)
+
 	for i := range pod.Spec.Containers {
 		container := &pod.Spec.Containers[i]
-		rcName, err := a.handelContainer(ctx, container, pod)
+		if group, ok := groups[container.Name]; ok {
+			spec, err := a.translateContainer(container, translation)
+			if err != nil {
+				a.cleanupResourceClaims(ctx, pod, rcNameList)
+				return errorResponse(err)
+			}
+			if spec != nil {
+				groupSpecs[group] = append(groupSpecs[group], spec)
+			}
+			continue
+		}
+		rcName, err := a.handelContainer(ctx, container, pod, translation)
 		if err != nil {
-			return admission.Errored(http.StatusInternalServerError, err)
+			a.cleanupResourceClaims(ctx, pod, rcNameList)
+			return errorResponse(err)
 		}
 		if rcName != "" {
 			needPatch = true
 			rcNameList = append(rcNameList, rcName)
-			container.Resources.Claims = []corev1.ResourceClaim{{Name: rcName}}
-			pod.Spec.ResourceClaims = append(pod.Spec.ResourceClaims, corev1.PodResourceClaim{
-				Name:              rcName,
-				ResourceClaimName: &rcName,
-			})
+			addPodResourceClaim(pod, container, rcName)
 		}
 	}
 
-	klog.V(5).InfoS("Pod after patching", "pod", pod)
+	// Containers of a shared GPU group reference a single claim, even those not requesting any GPU themselves.
+	for i := range pod.Spec.Containers {
+		container := &pod.Spec.Containers[i]
+		group, ok := groups[container.Name]
+		if !ok || len(groupSpecs[group]) == 0 {
+			continue
+		}
+		rcName := sharedResourceClaimName(pod, group)
+		if !slices.Contains(rcNameList, rcName) {
+			if err := a.createResourceClaim(ctx, pod, rcName, a.mergeSharedSpecs(groupSpecs[group])); err != nil {
+				a.cleanupResourceClaims(ctx, pod, rcNameList)
+				return admission.Errored(http.StatusInternalServerError, err)
+			}
+			needPatch = true
+			rcNameList = append(rcNameList, rcName)
+		}
+		addPodResourceClaim(pod, container, rcName)
+	}
+
 	if !needPatch {
 		klog.V(5).Infof("No need to patch Pod(%s/%s) for request: %s", req.Namespace, pod.Name, req.Operation)
 		return admission.Allowed("")
 	}
+	return a.patchPod(ctx, req, pod, rcNameList)
+}
 
+// patchPod labels the translated pod and returns the patch response.
+// The ResourceClaims in rcNameList are deleted if the pod cannot be marshaled.
+func (a *MutatingAdmission) patchPod(ctx context.Context, req admission.Request, pod *corev1.Pod, rcNameList []string) admission.Response {
+	klog.V(5).InfoS("Pod after patching", "pod", pod)
 	if pod.Labels == nil {
 		pod.Labels = make(map[string]string)
 	}
@@ -86,118 +184,161 @@ func (a *MutatingAdmission) Handle(ctx context.Context, req admission.Request) a
 
 	marshaledBytes, err := json.Marshal(pod)
 	if err != nil {
-		// Cleanup the ResourceClaims created for this pod
-		for _, rcName := range rcNameList {
-			deletionErr := a.Client.Delete(ctx, &resourceapi.ResourceClaim{
-				ObjectMeta: metav1.ObjectMeta{
-					Name:      rcName,
-					Namespace: pod.Namespace,
-				},
-			})
-			if deletionErr != nil {
-				klog.V(5).Infof("Failed to delete ResourceClaim(%s/%s) for request: %s after an error occurs", pod.Namespace, pod.Name, req.Operation)
-			}
-		}
+		a.cleanupResourceClaims(ctx, pod, rcNameList)
 		return admission.Errored(http.StatusInternalServerError, err)
 	}
 	return admission.PatchResponseFromRaw(req.Object.Raw, marshaledBytes)
 }
 
-func (a *MutatingAdmission) handelContainer(ctx context.Context, container *corev1.Container, pod *corev1.Pod) (string, error) {
-	countResourceName := corev1.ResourceName(a.DeviceConfig.ResourceCountName)
-	countQty, ok := container.Resources.Limits[countResourceName]
-	if !ok {
-		return "", nil
+func (a *MutatingAdmission) handelContainer(ctx context.Context, container *corev1.Container, pod *corev1.Pod, translation *podTranslation) (string, error) {
+	spec, err := a.translateContainer(container, translation)
+	if err != nil || spec == nil {
+		return "", err
 	}
 
-	rcName := fmt.Sprintf("%s-%s-%s", pod.Namespace, pod.Name, container.Name)
-	resourceclaim := a.buildResourceClaim(rcName, pod.Namespace)
-
-	resourceclaim.Spec.Devices.Requests[0].Exactly.Count = countQty.Value()
-
-	// Remove count resource from container
-	a.removeResource(container, countResourceName)
-
-	if coreQty, ok := container.Resources.Limits[corev1.ResourceName(a.DeviceConfig.ResourceCoreName)]; ok {
-		resourceclaim.Spec.Devices.Requests[0].Exactly.Capacity.Requests["cores"] = coreQty
-		a.removeResource(container, corev1.ResourceName(a.DeviceConfig.ResourceCoreName))
-	}
-	if memQty, ok := container.Resources.Limits[corev1.ResourceName(a.DeviceConfig.ResourceMemoryName)]; ok {
-		mem := resource.MustParse(fmt.Sprintf("%d", memQty.Value() * 1024 * 1024))
-		resourceclaim.Spec.Devices.Requests[0].Exactly.Capacity.Requests["memory"] = mem
-		a.removeResource(container, corev1.ResourceName(a.DeviceConfig.ResourceMemoryName))
+	rcName := resourceClaimName(pod, container.Name)
+	if err := a.createResourceClaim(ctx, pod, rcName, spec); err != nil {
+		return "", err
 	}
+	return rcName, nil
+}
 
-	a.addAnnotationSelectors(resourceclaim, pod)
+// createResourceClaim creates the ResourceClaim generated for the pod.
+func (a *MutatingAdmission) createResourceClaim(ctx context.Context, pod *corev1.Pod, rcName string, spec *resourceapi.ResourceClaimSpec) error {
+	resourceclaim := &resourceapi.ResourceClaim{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        rcName,
+			Namespace:   pod.Namespace,
+			Labels:      map[string]string{constants.DraLabel: "true"},
+			Annotations: map[string]string{constants.PodNameAnnotation: pod.Name},
+		},
+		Spec: *spec,
+	}
 
 	if err := a.Client.Create(ctx, resourceclaim); err != nil {
-		return "", fmt.Errorf("failed to create ResourceClaim %s/%s: %w", pod.Namespace, rcName, err)
+		return fmt.Errorf("failed to create ResourceClaim %s/%s: %w", pod.Namespace, rcName, err)
 	}
 
 	klog.V(4).Infof("Successfully created ResourceClaim %s/%s", pod.Namespace, rcName)
-	return rcName, nil
+	return nil
 }
 
-// buildResourceClaim creates a ResourceClaim with default selectors.
-func (a *MutatingAdmission) buildResourceClaim(name, namespace string) *resourceapi.ResourceClaim {
-	return &resourceapi.ResourceClaim{
-		ObjectMeta: metav1.ObjectMeta{
-			Name:      name,
-			Namespace: namespace,
-		},
-		Spec: resourceapi.ResourceClaimSpec{
-			Devices: resourceapi.DeviceClaim{
-				Requests: []resourceapi.DeviceRequest{
-					{
-						Name: "gpu",
-						Exactly: &resourceapi.ExactDeviceRequest{
-							AllocationMode: resourceapi.DeviceAllocationModeExactCount,
-							Capacity: &resourceapi.CapacityRequirements{
-								Requests: make(map[resourceapi.QualifiedName]resource.Quantity),
-							},
-							DeviceClassName: constants.NvidiaDraDriver,
-							Selectors: []resourceapi.DeviceSelector{
-								{
-									CEL: &resourceapi.CELDeviceSelector{
-										Expression: fmt.Sprintf(`device.attributes["%s"].type == "%s"`, constants.NvidiaDraDriver, constants.NvidiaDeviceType),
-									},
-								},
-							},
-						},
-					},
-				},
+// cleanupResourceClaims deletes the ResourceClaims created for this pod after an error occurs.
+func (a *MutatingAdmission) cleanupResourceClaims(ctx context.Context, pod *corev1.Pod, rcNameList []string) {
+	for _, rcName := range rcNameList {
+		deletionErr := a.Client.Delete(ctx, &resourceapi.ResourceClaim{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      rcName,
+				Namespace: pod.Namespace,
 			},
-		},
+		})
+		if deletionErr != nil {
+			klog.V(5).Infof("Failed to delete ResourceClaim(%s/%s) after an error occurs: %v", pod.Namespace, rcName, deletionErr)
+		}
+	}
+}
+
+// addPodResourceClaim makes the container use the named ResourceClaim, adding it to the pod if necessary.
+func addPodResourceClaim(pod *corev1.Pod, container *corev1.Container, rcName string) {
+	addContainerClaim(container, rcName)
+	for _, claim := range pod.Spec.ResourceClaims {
+		if claim.Name == rcName {
+			return
+		}
 	}
+	pod.Spec.ResourceClaims = append(pod.Spec.ResourceClaims, corev1.PodResourceClaim{
+		Name:              rcName,
+		ResourceClaimName: &rcName,
+	})
 }
 
-// removeResource removes a resource from both Requests and Limits
-func (a *MutatingAdmission) removeResource(container *corev1.Container, resourceName corev1.ResourceName) {
-	if container.Resources.Requests != nil {
-		delete(container.Resources.Requests, resourceName)
+// addContainerClaim makes the container use the pod claim named name, keeping the claims it already uses.
+func addContainerClaim(container *corev1.Container, name string) {
+	for _, claim := range container.Resources.Claims {
+		if claim.Name == name && claim.Request == "" {
+			return
+		}
 	}
-	if container.Resources.Limits != nil {
-		delete(container.Resources.Limits, resourceName)
+	container.Resources.Claims = append(container.Resources.Claims, corev1.ResourceClaim{Name: name})
+}
+
+// translateContainer removes the device resources from the container and returns the equivalent ResourceClaimSpec,
+// merging the device requests of every translator owning some of its resources.
+// It returns nil if the container does not request any device, and a denied error if a translator rejects the request.
+func (a *MutatingAdmission) translateContainer(container *corev1.Container, translation *podTranslation) (*resourceapi.ResourceClaimSpec, error) {
+	pod := &device.Pod{Annotations: translation.annotations, Policy: translation.policy}
+	var spec *resourceapi.ResourceClaimSpec
+	for _, translator := range translatorsFor(a.DeviceConfig) {
+		claim, err := translator.Translate(container, pod)
+		if err != nil {
+			return nil, err
+		}
+		if claim == nil {
+			continue
+		}
+		klog.V(5).Infof("Translator %s translated the resources of container %s", translator.Name(), container.Name)
+		rules, err := translation.matchingRules()
+		if err != nil {
+			return nil, err
+		}
+		applyRules(claim, translator.Name(), rules)
+		if spec == nil {
+			spec = &resourceapi.ResourceClaimSpec{}
+		}
+		spec.Devices.Requests = append(spec.Devices.Requests, claim.Requests...)
+		spec.Devices.Constraints = append(spec.Devices.Constraints, claim.Constraints...)
+		spec.Devices.Config = append(spec.Devices.Config, claim.Config...)
 	}
+	return spec, nil
 }
 
-// addAnnotationSelectors adds device selectors based on pod annotations.
-func (a *MutatingAdmission) addAnnotationSelectors(resourceclaim *resourceapi.ResourceClaim, pod *corev1.Pod) {
-	exactly := resourceclaim.Spec.Devices.Requests[0].Exactly
+// resourceClaimName returns the name of the ResourceClaim generated for the given container.
+func resourceClaimName(pod *corev1.Pod, containerName string) string {
+	return fmt.Sprintf("%s-%s-%s", pod.Namespace, pod.Name, containerName)
+}
 
-	if uuid, ok := pod.Annotations[constants.UseUUIDAnnotation]; ok {
-		exactly.Selectors = append(exactly.Selectors, resourceapi.DeviceSelector{
-			CEL: &resourceapi.CELDeviceSelector{
-				Expression: fmt.Sprintf(`device.attributes["%s"].uuid == "%s"`, constants.NvidiaDraDriver, uuid),
-			},
-		})
+// podTranslation is what the translation of the containers of a pod depends on, besides the device config.
+type podTranslation struct {
+	annotations map[string]string
+	policy      *policyv1alpha1.GPUPolicy
+	// pod is the pod the mutation rules are evaluated against, as it was before its containers were translated.
+	pod *corev1.Pod
+	// candidates are the mutation rules of the device config, rules are the ones matching the pod.
+	candidates []config.MutationRule
+	rules      []*config.MutationRule
+	matched    bool
+	// err is the error of the rule that failed to evaluate against the pod, if any.
+	err error
+}
+
+// newPodTranslation looks up the GPU policy of the namespace and applies its annotations to a pod requesting NVIDIA resources.
+// The mutation rules are only matched once a container of the pod requests devices.
+func (a *MutatingAdmission) newPodTranslation(ctx context.Context, namespace string, objectMeta *metav1.ObjectMeta, podSpec *corev1.PodSpec) (*podTranslation, error) {
+	policy, err := a.namespacePolicy(ctx, namespace)
+	if err != nil {
+		return nil, err
+	}
+	if a.requestsNvidia(podSpec) {
+		applyPolicyAnnotations(objectMeta, policy)
 	}
 
-	if deviceType, ok := pod.Annotations[constants.UseTypeAnnotation]; ok {
-		exactly.Selectors = append(exactly.Selectors, resourceapi.DeviceSelector{
-			CEL: &resourceapi.CELDeviceSelector{
-				Expression: fmt.Sprintf(`device.attributes["%s"].productName == "%s"`, constants.NvidiaDraDriver, deviceType),
-			},
-		})
+	translation := &podTranslation{
+		annotations: objectMeta.Annotations,
+		policy:      policy,
+		candidates:  a.Rules,
+	}
+	if len(a.Rules) > 0 {
+		translation.pod = &corev1.Pod{ObjectMeta: *objectMeta.DeepCopy(), Spec: *podSpec.DeepCopy()}
+		translation.pod.Namespace = namespace
+	}
+	return translation, nil
+}
+
+// errorResponse denies the request for a device.DeniedError and fails it for any other error.
+func errorResponse(err error) admission.Response {
+	var denied *device.DeniedError
+	if errors.As(err, &denied) {
+		return admission.Denied(denied.Message)
 	}
+	return admission.Errored(http.StatusInternalServerError, err)
 }
@@ -0,0 +1,89 @@
+/*
+Copyright 2025 The HAMi Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package dra
+
+import (
+	"testing"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+
+	"github.com/Project-HAMi/HAMi-DRA/pkg/config"
+)
+
+// nvidiaConfig returns a device config with the NVIDIA section and the defaults the webhook loads it with.
+func nvidiaConfig(nvidia config.NvidiaConfig) *config.Config {
+	deviceConfig := &config.Config{Nvidia: nvidia}
+	config.SetDefaults(deviceConfig)
+	return deviceConfig
+}
+
+func TestTranslateContainer(t *testing.T) {
+	tests := []struct {
+		Name           string
+		Limits         corev1.ResourceList
+		ExpectSpec     bool
+		ExpectRequests []string
+		ExpectLimits   int
+	}{
+		{
+			Name:         "no device resource",
+			Limits:       corev1.ResourceList{corev1.ResourceCPU: resource.MustParse("1")},
+			ExpectLimits: 1,
+		},
+		{
+			Name: "nvidia resources",
+			Limits: corev1.ResourceList{
+				corev1.ResourceCPU:  resource.MustParse("1"),
+				"nvidia.com/gpu":    resource.MustParse("1"),
+				"nvidia.com/gpumem": resource.MustParse("1000"),
+			},
+			ExpectSpec:     true,
+			ExpectRequests: []string{"gpu"},
+			ExpectLimits:   1,
+		},
+	}
+
+	for i := range tests {
+		tc := tests[i]
+
+		t.Run(tc.Name, func(t *testing.T) {
+			a := &MutatingAdmission{DeviceConfig: nvidiaConfig(config.NvidiaConfig{})}
+			container := &corev1.Container{Name: "main", Resources: corev1.ResourceRequirements{Limits: tc.Limits}}
+
+			spec, err := a.translateContainer(container, &podTranslation{})
+			if err != nil {
+				t.Fatalf("No error is expected but got: %v", err)
+			}
+			if (spec != nil) != tc.ExpectSpec {
+				t.Fatalf("expect a claim spec: %t, but got: %v", tc.ExpectSpec, spec)
+			}
+			if spec != nil {
+				var names []string
+				for _, request := range spec.Devices.Requests {
+					names = append(names, request.Name)
+				}
+				if len(names) != len(tc.ExpectRequests) || names[0] != tc.ExpectRequests[0] {
+					t.Fatalf("expect requests: %v, but got: %v", tc.ExpectRequests, names)
+				}
+			}
+			if len(container.Resources.Limits) != tc.ExpectLimits {
+				t.Fatalf("expect %d limits left, but got: %v", tc.ExpectLimits, container.Resources.Limits)
+			}
+		})
+	}
+}
@@ -0,0 +1,94 @@
+/*
+Copyright 2025 The HAMi Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package dra
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/klog/v2"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	policyv1alpha1 "github.com/Project-HAMi/HAMi-DRA/pkg/apis/policy/v1alpha1"
+	"github.com/Project-HAMi/HAMi-DRA/pkg/config"
+	"github.com/Project-HAMi/HAMi-DRA/pkg/constants"
+	"github.com/Project-HAMi/HAMi-DRA/pkg/device/nvidia"
+)
+
+// namespacePolicy returns the GPUPolicy of the namespace, or nil if it has none.
+// The GPUPolicy CRD is optional, a cluster without it has no policies.
+func (a *MutatingAdmission) namespacePolicy(ctx context.Context, namespace string) (*policyv1alpha1.GPUPolicy, error) {
+	policies := &policyv1alpha1.GPUPolicyList{}
+	if err := a.Client.List(ctx, policies, client.InNamespace(namespace)); err != nil {
+		if meta.IsNoMatchError(err) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to list GPUPolicies in namespace %s: %w", namespace, err)
+	}
+	if len(policies.Items) == 0 {
+		return nil, nil
+	}
+	sort.Slice(policies.Items, func(i, j int) bool {
+		return policies.Items[i].Name < policies.Items[j].Name
+	})
+	if len(policies.Items) > 1 {
+		klog.V(4).Infof("Namespace %s has %d GPUPolicies, using %s", namespace, len(policies.Items), policies.Items[0].Name)
+	}
+	return &policies.Items[0], nil
+}
+
+// requestsNvidia reports whether a container of the pod requests NVIDIA resources, the only ones the policy defaults apply to.
+func (a *MutatingAdmission) requestsNvidia(podSpec *corev1.PodSpec) bool {
+	for _, translator := range translatorsFor(a.DeviceConfig) {
+		if translator.Name() != nvidia.Name {
+			continue
+		}
+		for _, container := range podSpec.Containers {
+			for _, name := range translator.ResourceNames() {
+				_, limited := container.Resources.Limits[name]
+				_, requested := container.Resources.Requests[name]
+				if limited || requested {
+					return true
+				}
+			}
+		}
+	}
+	return false
+}
+
+// applyPolicyAnnotations sets the annotations the policy defaults on the object.
+// They only reach the API server if the object is patched for its GPU resources.
+func applyPolicyAnnotations(obj metav1.Object, policy *policyv1alpha1.GPUPolicy) {
+	if policy == nil {
+		return
+	}
+	annotations := obj.GetAnnotations()
+	if annotations == nil {
+		annotations = make(map[string]string)
+	}
+	if _, ok := annotations[constants.UseTypeAnnotation]; !ok && policy.Spec.DefaultGPUType != "" {
+		annotations[constants.UseTypeAnnotation] = policy.Spec.DefaultGPUType
+	}
+	if _, ok := annotations[config.AllocateMode]; !ok && policy.Spec.VGPUMode != "" {
+		annotations[config.AllocateMode] = policy.Spec.VGPUMode
+	}
+	obj.SetAnnotations(annotations)
+}
@@ -0,0 +1,182 @@
+/*
+Copyright 2025 The HAMi Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package dra
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	policyv1alpha1 "github.com/Project-HAMi/HAMi-DRA/pkg/apis/policy/v1alpha1"
+	"github.com/Project-HAMi/HAMi-DRA/pkg/config"
+	"github.com/Project-HAMi/HAMi-DRA/pkg/constants"
+	"github.com/Project-HAMi/HAMi-DRA/pkg/device"
+)
+
+func TestTranslateContainerWithPolicy(t *testing.T) {
+	policy := &policyv1alpha1.GPUPolicy{
+		ObjectMeta: metav1.ObjectMeta{Name: "tenant", Namespace: "default"},
+		Spec: policyv1alpha1.GPUPolicySpec{
+			DefaultMemory:       ptr.To[int32](2000),
+			DefaultGPUType:      "A100",
+			AllowedProductNames: []string{"A100", "H100"},
+			MaxGPUsPerContainer: ptr.To[int32](2),
+			VGPUMode:            config.HamiCoreMode,
+		},
+	}
+
+	tests := []struct {
+		Name            string
+		Count           string
+		Annotations     map[string]string
+		ExpectDenied    bool
+		ExpectSelectors int
+	}{
+		{
+			Name:            "defaults applied",
+			Count:           "1",
+			ExpectSelectors: 2,
+		},
+		{
+			Name:         "too many gpus",
+			Count:        "3",
+			ExpectDenied: true,
+		},
+		{
+			Name:         "gpu type not allowed",
+			Count:        "1",
+			Annotations:  map[string]string{constants.UseTypeAnnotation: "T4"},
+			ExpectDenied: true,
+		},
+		{
+			Name:         "other vgpu mode",
+			Count:        "1",
+			Annotations:  map[string]string{config.AllocateMode: config.MigMode},
+			ExpectDenied: true,
+		},
+	}
+
+	for i := range tests {
+		tc := tests[i]
+
+		t.Run(tc.Name, func(t *testing.T) {
+			a := &MutatingAdmission{
+				DeviceConfig: nvidiaConfig(config.NvidiaConfig{
+					ResourceCountName:  "nvidia.com/gpu",
+					ResourceMemoryName: "nvidia.com/gpumem",
+				}),
+			}
+			pod := &corev1.Pod{ObjectMeta: metav1.ObjectMeta{Annotations: tc.Annotations}}
+			applyPolicyAnnotations(pod, policy)
+			container := &corev1.Container{Resources: corev1.ResourceRequirements{Limits: corev1.ResourceList{
+				"nvidia.com/gpu": resource.MustParse(tc.Count),
+			}}}
+
+			spec, err := a.translateContainer(container, &podTranslation{annotations: pod.Annotations, policy: policy})
+			var denied *device.DeniedError
+			if tc.ExpectDenied {
+				if !errors.As(err, &denied) {
+					t.Fatalf("Expect denied error, but got: %v", err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("No error is expected but got: %v", err)
+			}
+			exactly := spec.Devices.Requests[0].Exactly
+			expectMemory := resource.NewQuantity(2000*1024*1024, resource.DecimalSI)
+			if memory := exactly.Capacity.Requests["memory"]; memory.Cmp(*expectMemory) != 0 {
+				t.Fatalf("expect memory: %s, but got: %s", expectMemory.String(), memory.String())
+			}
+			if len(exactly.Selectors) != tc.ExpectSelectors {
+				t.Fatalf("expect %d selectors, but got: %d", tc.ExpectSelectors, len(exactly.Selectors))
+			}
+			if pod.Annotations[config.AllocateMode] != config.HamiCoreMode {
+				t.Fatalf("expect vgpu-mode %s, but got: %s", config.HamiCoreMode, pod.Annotations[config.AllocateMode])
+			}
+		})
+	}
+}
+
+func TestNewPodTranslationPolicyAnnotations(t *testing.T) {
+	policy := &policyv1alpha1.GPUPolicy{
+		ObjectMeta: metav1.ObjectMeta{Name: "tenant", Namespace: "default"},
+		Spec:       policyv1alpha1.GPUPolicySpec{DefaultGPUType: "A100", VGPUMode: config.HamiCoreMode},
+	}
+	deviceConfig := nvidiaConfig(config.NvidiaConfig{})
+	deviceConfig.Cambricon.ResourceCountName = "cambricon.com/vmlu"
+
+	tests := []struct {
+		Name              string
+		Resources         corev1.ResourceRequirements
+		ExpectAnnotations bool
+	}{
+		{
+			Name:              "nvidia limits",
+			Resources:         corev1.ResourceRequirements{Limits: corev1.ResourceList{"nvidia.com/gpu": resource.MustParse("1")}},
+			ExpectAnnotations: true,
+		},
+		{
+			Name:              "nvidia requests",
+			Resources:         corev1.ResourceRequirements{Requests: corev1.ResourceList{"nvidia.com/gpu": resource.MustParse("1")}},
+			ExpectAnnotations: true,
+		},
+		{
+			Name:      "other vendor",
+			Resources: corev1.ResourceRequirements{Limits: corev1.ResourceList{"cambricon.com/vmlu": resource.MustParse("1")}},
+		},
+		{
+			Name:      "no device resource",
+			Resources: corev1.ResourceRequirements{Limits: corev1.ResourceList{corev1.ResourceCPU: resource.MustParse("1")}},
+		},
+	}
+
+	sch := runtime.NewScheme()
+	_ = scheme.AddToScheme(sch)
+	_ = policyv1alpha1.AddToScheme(sch)
+
+	for i := range tests {
+		tc := tests[i]
+
+		t.Run(tc.Name, func(t *testing.T) {
+			a := &MutatingAdmission{
+				Client:       fake.NewClientBuilder().WithScheme(sch).WithObjects(policy).Build(),
+				DeviceConfig: deviceConfig,
+			}
+			pod := &corev1.Pod{
+				ObjectMeta: metav1.ObjectMeta{Name: "pod", Namespace: "default"},
+				Spec:       corev1.PodSpec{Containers: []corev1.Container{{Name: "main", Resources: tc.Resources}}},
+			}
+			if _, err := a.newPodTranslation(context.TODO(), pod.Namespace, &pod.ObjectMeta, &pod.Spec); err != nil {
+				t.Fatalf("No error is expected but got: %v", err)
+			}
+			_, useType := pod.Annotations[constants.UseTypeAnnotation]
+			_, mode := pod.Annotations[config.AllocateMode]
+			if useType != tc.ExpectAnnotations || mode != tc.ExpectAnnotations {
+				t.Fatalf("expect policy annotations: %t, but got: %v", tc.ExpectAnnotations, pod.Annotations)
+			}
+		})
+	}
+}
@@ -0,0 +1,90 @@
+/*
+Copyright 2025 The HAMi Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package dra
+
+import (
+	resourceapi "k8s.io/api/resource/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	"k8s.io/klog/v2"
+
+	"github.com/Project-HAMi/HAMi-DRA/pkg/config"
+	"github.com/Project-HAMi/HAMi-DRA/pkg/device"
+)
+
+// matchingRules returns the mutation rules matching the pod, evaluating them on the first call.
+// A rule that fails to evaluate, such as one reading a label the pod does not have, denies the pod,
+// unless its failure policy is Ignore, in which case it does not match.
+func (t *podTranslation) matchingRules() ([]*config.MutationRule, error) {
+	if t.matched {
+		return t.rules, t.err
+	}
+	t.matched = true
+	for i := range t.candidates {
+		rule := &t.candidates[i]
+		matched, err := rule.Matches(t.pod)
+		if err != nil {
+			if rule.FailurePolicy == config.IgnoreRulePolicy {
+				klog.Warningf("Rule %s does not apply to Pod(%s/%s): %v", rule.Name, t.pod.Namespace, t.pod.Name, err)
+				continue
+			}
+			t.err = device.Deniedf("mutation rule %s cannot be evaluated against the pod: %v", rule.Name, err)
+			return nil, t.err
+		}
+		if matched {
+			klog.V(5).Infof("Rule %s matches Pod(%s/%s)", rule.Name, t.pod.Namespace, t.pod.Name)
+			t.rules = append(t.rules, rule)
+		}
+	}
+	return t.rules, nil
+}
+
+// applyRules adds the selectors, constraints and capacity defaults of the rules applying to the named translator
+// to every request of the device claim it returned.
+func applyRules(claim *resourceapi.DeviceClaim, translator string, rules []*config.MutationRule) {
+	for i := range claim.Requests {
+		request := &claim.Requests[i]
+		exactly := request.Exactly
+		for _, rule := range rules {
+			if !rule.AppliesTo(translator) {
+				continue
+			}
+			for _, selector := range rule.Selectors {
+				exactly.Selectors = append(exactly.Selectors, resourceapi.DeviceSelector{
+					CEL: &resourceapi.CELDeviceSelector{Expression: selector},
+				})
+			}
+			for _, constraint := range rule.Constraints {
+				attribute := resourceapi.FullyQualifiedName(constraint.MatchAttribute)
+				claim.Constraints = append(claim.Constraints, resourceapi.DeviceConstraint{
+					Requests:       []string{request.Name},
+					MatchAttribute: &attribute,
+				})
+			}
+			for name, qty := range rule.CapacityRequests() {
+				if exactly.Capacity == nil {
+					exactly.Capacity = &resourceapi.CapacityRequirements{}
+				}
+				if exactly.Capacity.Requests == nil {
+					exactly.Capacity.Requests = make(map[resourceapi.QualifiedName]resource.Quantity)
+				}
+				if _, ok := exactly.Capacity.Requests[resourceapi.QualifiedName(name)]; !ok {
+					exactly.Capacity.Requests[resourceapi.QualifiedName(name)] = qty.DeepCopy()
+				}
+			}
+		}
+	}
+}
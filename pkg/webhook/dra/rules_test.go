@@ -0,0 +1,237 @@
+/*
+Copyright 2025 The HAMi Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package dra
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"testing"
+
+	corev1 "k8s.io/api/core/v1"
+	resourceapi "k8s.io/api/resource/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	policyv1alpha1 "github.com/Project-HAMi/HAMi-DRA/pkg/apis/policy/v1alpha1"
+	"github.com/Project-HAMi/HAMi-DRA/pkg/config"
+	"github.com/Project-HAMi/HAMi-DRA/pkg/device"
+)
+
+// parseRules returns the compiled rules of a device config.
+func parseRules(t *testing.T, rules string) *config.Config {
+	t.Helper()
+	deviceConfig, err := config.Parse([]byte(rules), true)
+	if err != nil {
+		t.Fatalf("No error is expected but got: %v", err)
+	}
+	return deviceConfig
+}
+
+func TestMatchingRules(t *testing.T) {
+	tests := []struct {
+		Name         string
+		Labels       map[string]string
+		Limits       corev1.ResourceList
+		ExpectSpec   bool
+		ExpectDenied bool
+		ExpectRules  []string
+	}{
+		{
+			// The training rule fails to evaluate on the pod without annotations, its failure is ignored.
+			Name:        "matching pod",
+			Labels:      map[string]string{"team": "inference"},
+			Limits:      corev1.ResourceList{"nvidia.com/gpu": resource.MustParse("1")},
+			ExpectSpec:  true,
+			ExpectRules: []string{"inference", "all"},
+		},
+		{
+			Name:         "pod without labels",
+			Limits:       corev1.ResourceList{"nvidia.com/gpu": resource.MustParse("1")},
+			ExpectDenied: true,
+		},
+		{
+			Name:   "pod without gpu",
+			Limits: corev1.ResourceList{corev1.ResourceCPU: resource.MustParse("1")},
+		},
+	}
+
+	deviceConfig := parseRules(t, `
+rules:
+  - name: training
+    match: 'pod.metadata.annotations["team"] == "training"'
+    failurePolicy: Ignore
+  - name: inference
+    match: 'pod.metadata.labels["team"] == "inference"'
+    selectors:
+      - 'device.attributes["gpu.nvidia.com"].productName == "L4"'
+  - name: all
+    match: 'true'
+    capacity:
+      memory: 8Gi
+`)
+	sch := runtime.NewScheme()
+	_ = scheme.AddToScheme(sch)
+	_ = policyv1alpha1.AddToScheme(sch)
+
+	for i := range tests {
+		tc := tests[i]
+
+		t.Run(tc.Name, func(t *testing.T) {
+			a := &MutatingAdmission{
+				Client:       fake.NewClientBuilder().WithScheme(sch).Build(),
+				DeviceConfig: deviceConfig,
+				Rules:        deviceConfig.Rules,
+			}
+			pod := &corev1.Pod{
+				ObjectMeta: metav1.ObjectMeta{Name: "pod", Namespace: "default", Labels: tc.Labels},
+				Spec: corev1.PodSpec{Containers: []corev1.Container{
+					{Name: "main", Resources: corev1.ResourceRequirements{Limits: tc.Limits}},
+				}},
+			}
+			translation, err := a.newPodTranslation(context.TODO(), pod.Namespace, &pod.ObjectMeta, &pod.Spec)
+			if err != nil {
+				t.Fatalf("No error is expected but got: %v", err)
+			}
+
+			spec, err := a.translateContainer(&pod.Spec.Containers[0], translation)
+			var denied *device.DeniedError
+			if tc.ExpectDenied {
+				if !errors.As(err, &denied) {
+					t.Fatalf("Expect denied error, but got: %v", err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("No error is expected but got: %v", err)
+			}
+			if (spec != nil) != tc.ExpectSpec {
+				t.Fatalf("expect a claim spec: %t, but got: %v", tc.ExpectSpec, spec)
+			}
+			// Rules are only evaluated for pods requesting devices.
+			if translation.matched != tc.ExpectSpec {
+				t.Fatalf("expect rules evaluated: %t, but got: %t", tc.ExpectSpec, translation.matched)
+			}
+			var names []string
+			for _, rule := range translation.rules {
+				names = append(names, rule.Name)
+			}
+			if len(names) != len(tc.ExpectRules) {
+				t.Fatalf("expect rules: %v, but got: %v", tc.ExpectRules, names)
+			}
+			for j := range names {
+				if names[j] != tc.ExpectRules[j] {
+					t.Fatalf("expect rules: %v, but got: %v", tc.ExpectRules, names)
+				}
+			}
+		})
+	}
+}
+
+func TestApplyRules(t *testing.T) {
+	deviceConfig := parseRules(t, `
+rules:
+  - name: l4
+    match: 'true'
+    translators: [nvidia]
+    selectors:
+      - 'device.attributes["gpu.nvidia.com"].productName == "L4"'
+    constraints:
+      - matchAttribute: gpu.nvidia.com/pcieRoot
+    capacity:
+      memory: 8Gi
+      cores: "50"
+`)
+	rules := []*config.MutationRule{&deviceConfig.Rules[0]}
+
+	tests := []struct {
+		Name          string
+		Translator    string
+		ExpectApplied bool
+	}{
+		{
+			Name:          "targeted translator",
+			Translator:    "nvidia",
+			ExpectApplied: true,
+		},
+		{
+			Name:       "other translator",
+			Translator: "cambricon",
+		},
+	}
+
+	for i := range tests {
+		tc := tests[i]
+
+		t.Run(tc.Name, func(t *testing.T) {
+			claim := &resourceapi.DeviceClaim{Requests: []resourceapi.DeviceRequest{
+				{Name: "gpu", Exactly: &resourceapi.ExactDeviceRequest{
+					DeviceClassName: "gpu.example.com",
+					Count:           2,
+					Capacity: &resourceapi.CapacityRequirements{Requests: map[resourceapi.QualifiedName]resource.Quantity{
+						"memory": resource.MustParse("2Gi"),
+					}},
+				}},
+			}}
+			applyRules(claim, tc.Translator, rules)
+
+			exactly := claim.Requests[0].Exactly
+			if !tc.ExpectApplied {
+				if len(exactly.Selectors) > 0 || len(claim.Constraints) > 0 || len(exactly.Capacity.Requests) != 1 {
+					t.Fatalf("expect the claim unchanged, but got: %+v", claim)
+				}
+				return
+			}
+			if len(exactly.Selectors) != 1 || exactly.Selectors[0].CEL.Expression != deviceConfig.Rules[0].Selectors[0] {
+				t.Fatalf("expect the rule selector, but got: %v", exactly.Selectors)
+			}
+			if len(claim.Constraints) != 1 || string(*claim.Constraints[0].MatchAttribute) != "gpu.nvidia.com/pcieRoot" ||
+				claim.Constraints[0].Requests[0] != "gpu" {
+				t.Fatalf("expect the rule constraint on request gpu, but got: %v", claim.Constraints)
+			}
+			// The capacity requested by the container is kept, the rule only fills in the missing ones.
+			if memory := exactly.Capacity.Requests["memory"]; memory.String() != "2Gi" {
+				t.Fatalf("expect memory: 2Gi, but got: %s", memory.String())
+			}
+			if cores := exactly.Capacity.Requests["cores"]; cores.String() != "50" {
+				t.Fatalf("expect cores: 50, but got: %s", cores.String())
+			}
+		})
+	}
+}
+
+func TestTranslatorsFor(t *testing.T) {
+	deviceConfig := nvidiaConfig(config.NvidiaConfig{})
+	translators := translatorsFor(deviceConfig)
+	if again := translatorsFor(deviceConfig); &again[0] != &translators[0] {
+		t.Fatal("expect the translators of a config to be created once")
+	}
+	reloaded := nvidiaConfig(config.NvidiaConfig{})
+	if other := translatorsFor(reloaded); &other[0] == &translators[0] {
+		t.Fatal("expect new translators for a reloaded config")
+	}
+}
+
+func TestTranslatorNames(t *testing.T) {
+	// Rules are validated against the translator names known to the config, which must be the registered ones.
+	if names := device.Names(); !slices.Equal(names, config.TranslatorNames) {
+		t.Fatalf("expect config.TranslatorNames to be %v, but got: %v", names, config.TranslatorNames)
+	}
+}
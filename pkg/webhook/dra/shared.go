@@ -0,0 +1,334 @@
+/*
+Copyright 2025 The HAMi Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package dra
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"sort"
+	"strings"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	resourceapi "k8s.io/api/resource/v1"
+	apiequality "k8s.io/apimachinery/pkg/api/equality"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/validation"
+	"k8s.io/klog/v2"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	"github.com/Project-HAMi/HAMi-DRA/pkg/config"
+	"github.com/Project-HAMi/HAMi-DRA/pkg/constants"
+	"github.com/Project-HAMi/HAMi-DRA/pkg/device"
+)
+
+// sharedGPUGroups parses the shared GPU group annotations of the pod and returns the group of each member container.
+func sharedGPUGroups(pod *corev1.Pod) (map[string]string, error) {
+	containers := make(map[string]bool, len(pod.Spec.Containers))
+	for _, container := range pod.Spec.Containers {
+		containers[container.Name] = true
+	}
+
+	keys := make([]string, 0)
+	for key := range pod.Annotations {
+		if strings.HasPrefix(key, constants.SharedGPUGroupAnnotationPrefix) {
+			keys = append(keys, key)
+		}
+	}
+	sort.Strings(keys)
+
+	groups := make(map[string]string)
+	for _, key := range keys {
+		group := strings.TrimPrefix(key, constants.SharedGPUGroupAnnotationPrefix)
+		if errs := validation.IsDNS1123Label(group); len(errs) > 0 {
+			return nil, fmt.Errorf("invalid shared GPU group name %q in annotation %s: %s", group, key, strings.Join(errs, "; "))
+		}
+		for _, member := range strings.Split(pod.Annotations[key], ",") {
+			member = strings.TrimSpace(member)
+			if member == "" {
+				continue
+			}
+			if !containers[member] {
+				return nil, fmt.Errorf("shared GPU group %q references unknown container %q", group, member)
+			}
+			if other, ok := groups[member]; ok {
+				return nil, fmt.Errorf("container %q cannot be in both shared GPU groups %q and %q", member, other, group)
+			}
+			groups[member] = group
+		}
+	}
+	return groups, nil
+}
+
+// sharedGPUGroupAnnotation returns the key of a shared GPU group annotation in annotations, if any.
+func sharedGPUGroupAnnotation(annotations map[string]string) (string, bool) {
+	for key := range annotations {
+		if strings.HasPrefix(key, constants.SharedGPUGroupAnnotationPrefix) {
+			return key, true
+		}
+	}
+	return "", false
+}
+
+// sharedResourceClaimName returns the name of the ResourceClaim generated for the given shared GPU group.
+func sharedResourceClaimName(pod *corev1.Pod, group string) string {
+	return fmt.Sprintf("%s-%s-shared-%s", pod.Namespace, pod.Name, group)
+}
+
+// mergeSharedSpecs merges the claim specs of the containers in a shared GPU group into one.
+// Requests of the same name are merged: the device count is the largest one requested, capacities follow the configured policy.
+func (a *MutatingAdmission) mergeSharedSpecs(specs []*resourceapi.ResourceClaimSpec) *resourceapi.ResourceClaimSpec {
+	merged := specs[0].DeepCopy()
+	for _, spec := range specs[1:] {
+		for i := range spec.Devices.Requests {
+			other := &spec.Devices.Requests[i]
+			index := slices.IndexFunc(merged.Devices.Requests, func(request resourceapi.DeviceRequest) bool {
+				return request.Name == other.Name
+			})
+			if index < 0 {
+				merged.Devices.Requests = append(merged.Devices.Requests, *other.DeepCopy())
+				continue
+			}
+			a.mergeExactRequests(merged.Devices.Requests[index].Exactly, other.Exactly)
+		}
+		for _, constraint := range spec.Devices.Constraints {
+			if !slices.ContainsFunc(merged.Devices.Constraints, func(existing resourceapi.DeviceConstraint) bool {
+				return apiequality.Semantic.DeepEqual(existing, constraint)
+			}) {
+				merged.Devices.Constraints = append(merged.Devices.Constraints, *constraint.DeepCopy())
+			}
+		}
+		for _, claimConfig := range spec.Devices.Config {
+			if !slices.ContainsFunc(merged.Devices.Config, func(existing resourceapi.DeviceClaimConfiguration) bool {
+				return apiequality.Semantic.DeepEqual(existing, claimConfig)
+			}) {
+				merged.Devices.Config = append(merged.Devices.Config, *claimConfig.DeepCopy())
+			}
+		}
+	}
+	return merged
+}
+
+// mergeExactRequests merges the other request into exactly.
+func (a *MutatingAdmission) mergeExactRequests(exactly, other *resourceapi.ExactDeviceRequest) {
+	if exactly == nil || other == nil {
+		return
+	}
+	if other.Count > exactly.Count {
+		exactly.Count = other.Count
+	}
+	if other.Capacity == nil {
+		return
+	}
+	if exactly.Capacity == nil {
+		exactly.Capacity = &resourceapi.CapacityRequirements{}
+	}
+	if exactly.Capacity.Requests == nil {
+		exactly.Capacity.Requests = make(map[resourceapi.QualifiedName]resource.Quantity)
+	}
+	for name, qty := range other.Capacity.Requests {
+		current, ok := exactly.Capacity.Requests[name]
+		switch {
+		case !ok:
+			current = qty.DeepCopy()
+		case a.DeviceConfig.Nvidia.SharedGPUCapacityPolicy == config.SumCapacityPolicy:
+			current.Add(qty)
+		case qty.Cmp(current) > 0:
+			current = qty.DeepCopy()
+		}
+		exactly.Capacity.Requests[name] = current
+	}
+}
+
+// errNotSharedClaim is returned when the named ResourceClaim exists but was not created as a shared GPU claim.
+var errNotSharedClaim = errors.New("not a shared GPU claim")
+
+// handleSharedClaimPod makes every GPU container of the pod use the named shared ResourceClaim,
+// creating it from the pod's requests if this is the first pod using it.
+func (a *MutatingAdmission) handleSharedClaimPod(ctx context.Context, req admission.Request, pod *corev1.Pod, translation *podTranslation, name string) admission.Response {
+	if errs := validation.IsDNS1123Label(name); len(errs) > 0 {
+		return admission.Denied(fmt.Sprintf("invalid shared GPU claim name %q in annotation %s: %s",
+			name, constants.SharedGPUClaimAnnotation, strings.Join(errs, "; ")))
+	}
+
+	var specs []*resourceapi.ResourceClaimSpec
+	var users []*corev1.Container
+	for i := range pod.Spec.Containers {
+		container := &pod.Spec.Containers[i]
+		spec, err := a.translateContainer(container, translation)
+		if err != nil {
+			return errorResponse(err)
+		}
+		if spec != nil {
+			specs = append(specs, spec)
+			users = append(users, container)
+		}
+	}
+	if len(specs) == 0 {
+		klog.V(5).Infof("No need to patch Pod(%s/%s) for request: %s", req.Namespace, pod.Name, req.Operation)
+		return admission.Allowed("")
+	}
+
+	created, err := a.ensureSharedResourceClaim(ctx, pod, name, a.mergeSharedSpecs(specs))
+	if errors.Is(err, errNotSharedClaim) {
+		return admission.Denied(err.Error())
+	}
+	if err != nil {
+		return errorResponse(err)
+	}
+
+	for _, container := range users {
+		addPodResourceClaim(pod, container, name)
+	}
+	if pod.Labels == nil {
+		pod.Labels = make(map[string]string)
+	}
+	pod.Labels[constants.SharedGPUClaimLabel] = name
+
+	// Only a claim created for this pod is cleaned up, an existing one may be in use by other pods.
+	var rcNameList []string
+	if created {
+		rcNameList = append(rcNameList, name)
+	}
+	return a.patchPod(ctx, req, pod, rcNameList)
+}
+
+// ensureSharedResourceClaim creates the named shared ResourceClaim, or marks the existing one as used.
+// It reports whether the claim was created.
+func (a *MutatingAdmission) ensureSharedResourceClaim(ctx context.Context, pod *corev1.Pod, name string, spec *resourceapi.ResourceClaimSpec) (bool, error) {
+	now := time.Now().UTC().Format(time.RFC3339)
+	resourceclaim := &resourceapi.ResourceClaim{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: pod.Namespace,
+			Labels: map[string]string{
+				constants.DraLabel:            "true",
+				constants.SharedGPUClaimLabel: name,
+			},
+			Annotations: map[string]string{constants.SharedGPUClaimLastUsedAnnotation: now},
+		},
+		Spec: *spec,
+	}
+	err := a.Client.Create(ctx, resourceclaim)
+	if err == nil {
+		klog.V(4).Infof("Successfully created shared ResourceClaim %s/%s", pod.Namespace, name)
+		return true, nil
+	}
+	if !apierrors.IsAlreadyExists(err) {
+		return false, fmt.Errorf("failed to create shared ResourceClaim %s/%s: %w", pod.Namespace, name, err)
+	}
+
+	// The claim was just created by another pod if both raced, the cache may not have it yet.
+	var reader client.Reader = a.Client
+	if a.APIReader != nil {
+		reader = a.APIReader
+	}
+	existing := &resourceapi.ResourceClaim{}
+	if err := reader.Get(ctx, types.NamespacedName{Namespace: pod.Namespace, Name: name}, existing); err != nil {
+		return false, fmt.Errorf("failed to get shared ResourceClaim %s/%s: %w", pod.Namespace, name, err)
+	}
+	if existing.Labels[constants.SharedGPUClaimLabel] != name {
+		return false, fmt.Errorf("ResourceClaim %s/%s already exists and is %w", pod.Namespace, name, errNotSharedClaim)
+	}
+	if !existing.DeletionTimestamp.IsZero() {
+		return false, device.Deniedf("shared GPU claim %s/%s is being deleted, create the pod again once it is gone", pod.Namespace, name)
+	}
+	if diff := sharedClaimSpecDiff(&existing.Spec, spec); diff != "" {
+		return false, device.Deniedf("shared GPU claim %s/%s was created for other GPU resources: %s; "+
+			"request the same GPU resources as the pods already using it, or use another claim name", pod.Namespace, name, diff)
+	}
+
+	// Record the new user so the cleanup controller does not delete the claim before the pod is persisted.
+	patch := client.MergeFrom(existing.DeepCopy())
+	if existing.Annotations == nil {
+		existing.Annotations = make(map[string]string)
+	}
+	existing.Annotations[constants.SharedGPUClaimLastUsedAnnotation] = now
+	if err := a.Client.Patch(ctx, existing, patch); err != nil {
+		return false, fmt.Errorf("failed to update shared ResourceClaim %s/%s: %w", pod.Namespace, name, err)
+	}
+
+	klog.V(4).Infof("Reusing shared ResourceClaim %s/%s", pod.Namespace, name)
+	return false, nil
+}
+
+// sharedClaimSpecDiff describes the first difference between the requests of an existing shared claim and
+// the requests of a pod joining it, or returns an empty string if the pod gets the devices it asks for.
+func sharedClaimSpecDiff(existing, joining *resourceapi.ResourceClaimSpec) string {
+	if !apiequality.Semantic.DeepEqual(existing.Devices.Constraints, joining.Devices.Constraints) {
+		return "the constraints differ"
+	}
+	if !apiequality.Semantic.DeepEqual(existing.Devices.Config, joining.Devices.Config) {
+		return "the device config differs"
+	}
+	if len(existing.Devices.Requests) != len(joining.Devices.Requests) {
+		return fmt.Sprintf("the claim has %d device requests, the pod %d", len(existing.Devices.Requests), len(joining.Devices.Requests))
+	}
+	for _, request := range joining.Devices.Requests {
+		i := slices.IndexFunc(existing.Devices.Requests, func(r resourceapi.DeviceRequest) bool { return r.Name == request.Name })
+		if i < 0 {
+			return fmt.Sprintf("the claim has no request %q", request.Name)
+		}
+		have, want := existing.Devices.Requests[i].Exactly, request.Exactly
+		if have == nil || want == nil {
+			if have != want {
+				return fmt.Sprintf("request %q differs", request.Name)
+			}
+			continue
+		}
+		if have.DeviceClassName != want.DeviceClassName {
+			return fmt.Sprintf("request %q uses device class %s, the pod %s", request.Name, have.DeviceClassName, want.DeviceClassName)
+		}
+		if have.Count != want.Count {
+			return fmt.Sprintf("request %q has %d devices, the pod asks for %d", request.Name, have.Count, want.Count)
+		}
+		if !apiequality.Semantic.DeepEqual(have.Selectors, want.Selectors) {
+			return fmt.Sprintf("request %q selects other devices", request.Name)
+		}
+		if !equalCapacity(have.Capacity, want.Capacity) {
+			return fmt.Sprintf("request %q has capacity %v, the pod asks for %v", request.Name, capacityRequests(have.Capacity), capacityRequests(want.Capacity))
+		}
+	}
+	return ""
+}
+
+func equalCapacity(a, b *resourceapi.CapacityRequirements) bool {
+	x, y := capacityRequests(a), capacityRequests(b)
+	if len(x) != len(y) {
+		return false
+	}
+	for name, qty := range x {
+		other, ok := y[name]
+		if !ok || qty.Cmp(other) != 0 {
+			return false
+		}
+	}
+	return true
+}
+
+func capacityRequests(capacity *resourceapi.CapacityRequirements) map[resourceapi.QualifiedName]resource.Quantity {
+	if capacity == nil {
+		return nil
+	}
+	return capacity.Requests
+}
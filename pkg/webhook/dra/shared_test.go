@@ -0,0 +1,443 @@
+/*
+Copyright 2025 The HAMi Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package dra
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"maps"
+	"testing"
+
+	corev1 "k8s.io/api/core/v1"
+	resourceapi "k8s.io/api/resource/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	"github.com/Project-HAMi/HAMi-DRA/pkg/config"
+	"github.com/Project-HAMi/HAMi-DRA/pkg/constants"
+	"github.com/Project-HAMi/HAMi-DRA/pkg/device"
+)
+
+func TestSharedGPUGroups(t *testing.T) {
+	tests := []struct {
+		Name         string
+		Annotations  map[string]string
+		ExpectGroups map[string]string
+		ExpectError  bool
+	}{
+		{
+			Name:         "no group",
+			Annotations:  map[string]string{"app": "demo"},
+			ExpectGroups: map[string]string{},
+		},
+		{
+			Name:         "one group",
+			Annotations:  map[string]string{"shared-gpu-group.hami.io/infer": "server, sidecar"},
+			ExpectGroups: map[string]string{"server": "infer", "sidecar": "infer"},
+		},
+		{
+			Name: "two groups",
+			Annotations: map[string]string{
+				"shared-gpu-group.hami.io/infer":   "server",
+				"shared-gpu-group.hami.io/metrics": "sidecar,",
+			},
+			ExpectGroups: map[string]string{"server": "infer", "sidecar": "metrics"},
+		},
+		{
+			Name:        "invalid group name",
+			Annotations: map[string]string{"shared-gpu-group.hami.io/Infer": "server"},
+			ExpectError: true,
+		},
+		{
+			Name:        "unknown container",
+			Annotations: map[string]string{"shared-gpu-group.hami.io/infer": "server,proxy"},
+			ExpectError: true,
+		},
+		{
+			Name: "container in two groups",
+			Annotations: map[string]string{
+				"shared-gpu-group.hami.io/infer":   "server,sidecar",
+				"shared-gpu-group.hami.io/metrics": "sidecar",
+			},
+			ExpectError: true,
+		},
+	}
+
+	for i := range tests {
+		tc := tests[i]
+
+		t.Run(tc.Name, func(t *testing.T) {
+			pod := &corev1.Pod{
+				ObjectMeta: metav1.ObjectMeta{Name: "pod", Namespace: "default", Annotations: tc.Annotations},
+				Spec: corev1.PodSpec{
+					Containers: []corev1.Container{{Name: "server"}, {Name: "sidecar"}},
+				},
+			}
+			groups, err := sharedGPUGroups(pod)
+			if tc.ExpectError {
+				if err == nil {
+					t.Fatalf("Expect error, but got nil")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("No error is expected but got: %v", err)
+			}
+			if !maps.Equal(groups, tc.ExpectGroups) {
+				t.Fatalf("expect groups: %v, but got: %v", tc.ExpectGroups, groups)
+			}
+		})
+	}
+}
+
+func TestMergeSharedSpecs(t *testing.T) {
+	tests := []struct {
+		Name         string
+		Policy       config.SharedGPUCapacityPolicy
+		ExpectCount  int64
+		ExpectMemory string
+	}{
+		{
+			Name:         "max policy",
+			Policy:       config.MaxCapacityPolicy,
+			ExpectCount:  2,
+			ExpectMemory: "3000",
+		},
+		{
+			Name:         "sum policy",
+			Policy:       config.SumCapacityPolicy,
+			ExpectCount:  2,
+			ExpectMemory: "4000",
+		},
+	}
+
+	for i := range tests {
+		tc := tests[i]
+
+		t.Run(tc.Name, func(t *testing.T) {
+			a := &MutatingAdmission{
+				DeviceConfig: nvidiaConfig(config.NvidiaConfig{
+					ResourceCountName:       "nvidia.com/gpu",
+					ResourceMemoryName:      "nvidia.com/gpumem",
+					SharedGPUCapacityPolicy: tc.Policy,
+				}),
+			}
+			server := &corev1.Container{Resources: corev1.ResourceRequirements{Limits: corev1.ResourceList{
+				"nvidia.com/gpu":    resource.MustParse("1"),
+				"nvidia.com/gpumem": resource.MustParse("3000"),
+			}}}
+			sidecar := &corev1.Container{Resources: corev1.ResourceRequirements{Limits: corev1.ResourceList{
+				"nvidia.com/gpu":    resource.MustParse("2"),
+				"nvidia.com/gpumem": resource.MustParse("1000"),
+			}}}
+
+			serverSpec, err := a.translateContainer(server, &podTranslation{})
+			if err != nil {
+				t.Fatalf("No error is expected but got: %v", err)
+			}
+			sidecarSpec, err := a.translateContainer(sidecar, &podTranslation{})
+			if err != nil {
+				t.Fatalf("No error is expected but got: %v", err)
+			}
+			merged := a.mergeSharedSpecs([]*resourceapi.ResourceClaimSpec{serverSpec, sidecarSpec})
+			exactly := merged.Devices.Requests[0].Exactly
+			if exactly.Count != tc.ExpectCount {
+				t.Fatalf("expect count: %d, but got: %d", tc.ExpectCount, exactly.Count)
+			}
+			expectMemory := resource.MustParse(tc.ExpectMemory)
+			expectMemory.Set(expectMemory.Value() * 1024 * 1024)
+			if memory := exactly.Capacity.Requests["memory"]; memory.Cmp(expectMemory) != 0 {
+				t.Fatalf("expect memory: %s, but got: %s", expectMemory.String(), memory.String())
+			}
+		})
+	}
+}
+
+// gpuClaimSpec returns the claim spec of a container asking for count GPUs with memory each.
+func gpuClaimSpec(t *testing.T, a *MutatingAdmission, count, memory string) *resourceapi.ResourceClaimSpec {
+	container := &corev1.Container{Resources: corev1.ResourceRequirements{Limits: corev1.ResourceList{
+		"nvidia.com/gpu":    resource.MustParse(count),
+		"nvidia.com/gpumem": resource.MustParse(memory),
+	}}}
+	spec, err := a.translateContainer(container, &podTranslation{})
+	if err != nil {
+		t.Fatalf("No error is expected but got: %v", err)
+	}
+	return spec
+}
+
+// sharedResourceClaim returns the shared claim named name with the spec.
+func sharedResourceClaim(name string, spec *resourceapi.ResourceClaimSpec) *resourceapi.ResourceClaim {
+	return &resourceapi.ResourceClaim{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        name,
+			Namespace:   "default",
+			Labels:      map[string]string{constants.DraLabel: "true", constants.SharedGPUClaimLabel: name},
+			Annotations: map[string]string{constants.SharedGPUClaimLastUsedAnnotation: "2025-01-01T00:00:00Z"},
+		},
+		Spec: *spec,
+	}
+}
+
+func TestEnsureSharedResourceClaim(t *testing.T) {
+	a := &MutatingAdmission{DeviceConfig: nvidiaConfig(config.NvidiaConfig{})}
+	spec := gpuClaimSpec(t, a, "1", "2000")
+	unshared := sharedResourceClaim("team", spec)
+	unshared.Labels = map[string]string{constants.DraLabel: "true"}
+	otherSelectors := sharedResourceClaim("team", spec.DeepCopy())
+	otherSelectors.Spec.Devices.Requests[0].Exactly.Selectors = []resourceapi.DeviceSelector{{CEL: &resourceapi.CELDeviceSelector{Expression: "true"}}}
+	otherConstraints := sharedResourceClaim("team", spec)
+	otherConstraints.Spec.Devices.Constraints = []resourceapi.DeviceConstraint{{MatchAttribute: ptr.To[resourceapi.FullyQualifiedName]("gpu.hami.io/numa")}}
+	otherConfig := sharedResourceClaim("team", spec)
+	otherConfig.Spec.Devices.Config = []resourceapi.DeviceClaimConfiguration{{DeviceConfiguration: resourceapi.DeviceConfiguration{
+		Opaque: &resourceapi.OpaqueDeviceConfiguration{Driver: "gpu.hami.io", Parameters: runtime.RawExtension{Raw: []byte(`{}`)}},
+	}}}
+	deleting := sharedResourceClaim("team", spec)
+	deleting.Finalizers = []string{"resource.kubernetes.io/delete-protection"}
+	deleting.DeletionTimestamp = ptr.To(metav1.Now())
+
+	tests := []struct {
+		Name     string
+		Existing *resourceapi.ResourceClaim
+		// Uncached reports whether the existing claim is missing from the cache of the client.
+		Uncached      bool
+		ExpectCreated bool
+		ExpectDenied  bool
+		ExpectError   bool
+	}{
+		{
+			Name:          "first user",
+			ExpectCreated: true,
+		},
+		{
+			Name:     "same resources",
+			Existing: sharedResourceClaim("team", spec),
+		},
+		{
+			Name:        "not a shared claim",
+			Existing:    unshared,
+			ExpectError: true,
+		},
+		{
+			Name:         "other device count",
+			Existing:     sharedResourceClaim("team", gpuClaimSpec(t, a, "2", "2000")),
+			ExpectDenied: true,
+		},
+		{
+			Name:         "other memory",
+			Existing:     sharedResourceClaim("team", gpuClaimSpec(t, a, "1", "4000")),
+			ExpectDenied: true,
+		},
+		{
+			Name:         "other selectors",
+			Existing:     otherSelectors,
+			ExpectDenied: true,
+		},
+		{
+			Name:         "other constraints",
+			Existing:     otherConstraints,
+			ExpectDenied: true,
+		},
+		{
+			Name:         "other config",
+			Existing:     otherConfig,
+			ExpectDenied: true,
+		},
+		{
+			Name:         "claim being deleted",
+			Existing:     deleting,
+			ExpectDenied: true,
+		},
+		{
+			Name:     "claim just created by another pod",
+			Existing: sharedResourceClaim("team", spec),
+			Uncached: true,
+		},
+	}
+
+	for i := range tests {
+		tc := tests[i]
+
+		t.Run(tc.Name, func(t *testing.T) {
+			builder := fake.NewClientBuilder()
+			if tc.Existing != nil {
+				builder = builder.WithObjects(tc.Existing)
+			}
+			reader := builder.Build()
+			a := &MutatingAdmission{Client: reader, APIReader: reader, DeviceConfig: a.DeviceConfig}
+			if tc.Uncached {
+				a.Client = interceptor.NewClient(reader.(client.WithWatch), interceptor.Funcs{
+					Get: func(ctx context.Context, cl client.WithWatch, key client.ObjectKey, obj client.Object, opts ...client.GetOption) error {
+						return apierrors.NewNotFound(resourceapi.Resource("resourceclaims"), key.Name)
+					},
+				})
+			}
+			pod := &corev1.Pod{ObjectMeta: metav1.ObjectMeta{Name: "pod", Namespace: "default"}}
+
+			created, err := a.ensureSharedResourceClaim(context.TODO(), pod, "team", spec)
+			var denied *device.DeniedError
+			if tc.ExpectDenied != errors.As(err, &denied) {
+				t.Fatalf("expect denied: %v, but got: %v", tc.ExpectDenied, err)
+			}
+			if tc.ExpectDenied {
+				return
+			}
+			if tc.ExpectError {
+				if !errors.Is(err, errNotSharedClaim) {
+					t.Fatalf("expect %v, but got: %v", errNotSharedClaim, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("No error is expected but got: %v", err)
+			}
+			if created != tc.ExpectCreated {
+				t.Fatalf("expect created: %v, but got: %v", tc.ExpectCreated, created)
+			}
+
+			claim := &resourceapi.ResourceClaim{}
+			if err := reader.Get(context.TODO(), types.NamespacedName{Namespace: "default", Name: "team"}, claim); err != nil {
+				t.Fatalf("No error is expected but got: %v", err)
+			}
+			if claim.Labels[constants.SharedGPUClaimLabel] != "team" {
+				t.Fatalf("expect label %s on the claim, but got: %v", constants.SharedGPUClaimLabel, claim.Labels)
+			}
+			// Every pod joining the claim records itself as its last user.
+			if lastUsed := claim.Annotations[constants.SharedGPUClaimLastUsedAnnotation]; lastUsed == "" || lastUsed == "2025-01-01T00:00:00Z" {
+				t.Fatalf("expect the last used time to be updated, but got: %q", lastUsed)
+			}
+		})
+	}
+}
+
+func TestHandleSharedClaimPod(t *testing.T) {
+	a := &MutatingAdmission{DeviceConfig: nvidiaConfig(config.NvidiaConfig{})}
+
+	tests := []struct {
+		Name         string
+		ClaimName    string
+		Limits       corev1.ResourceList
+		Existing     *resourceapi.ResourceClaim
+		ExpectDenied bool
+		ExpectPatch  bool
+	}{
+		{
+			Name:      "first pod",
+			ClaimName: "team",
+			Limits: corev1.ResourceList{
+				"nvidia.com/gpu":    resource.MustParse("1"),
+				"nvidia.com/gpumem": resource.MustParse("2000"),
+			},
+			ExpectPatch: true,
+		},
+		{
+			Name:      "joining pod",
+			ClaimName: "team",
+			Limits: corev1.ResourceList{
+				"nvidia.com/gpu":    resource.MustParse("1"),
+				"nvidia.com/gpumem": resource.MustParse("2000"),
+			},
+			Existing:    sharedResourceClaim("team", gpuClaimSpec(t, a, "1", "2000")),
+			ExpectPatch: true,
+		},
+		{
+			Name:      "joining pod asking for more memory",
+			ClaimName: "team",
+			Limits: corev1.ResourceList{
+				"nvidia.com/gpu":    resource.MustParse("1"),
+				"nvidia.com/gpumem": resource.MustParse("8000"),
+			},
+			Existing:     sharedResourceClaim("team", gpuClaimSpec(t, a, "1", "2000")),
+			ExpectDenied: true,
+		},
+		{
+			Name:      "invalid claim name",
+			ClaimName: "Team",
+			Limits: corev1.ResourceList{
+				"nvidia.com/gpu": resource.MustParse("1"),
+			},
+			ExpectDenied: true,
+		},
+		{
+			Name:      "no gpu",
+			ClaimName: "team",
+			Limits:    corev1.ResourceList{corev1.ResourceCPU: resource.MustParse("1")},
+		},
+	}
+
+	for i := range tests {
+		tc := tests[i]
+
+		t.Run(tc.Name, func(t *testing.T) {
+			builder := fake.NewClientBuilder()
+			if tc.Existing != nil {
+				builder = builder.WithObjects(tc.Existing)
+			}
+			a := &MutatingAdmission{Client: builder.Build(), DeviceConfig: a.DeviceConfig}
+			pod := &corev1.Pod{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:        "pod",
+					Namespace:   "default",
+					Annotations: map[string]string{constants.SharedGPUClaimAnnotation: tc.ClaimName},
+				},
+				Spec: corev1.PodSpec{Containers: []corev1.Container{
+					{Name: "main", Resources: corev1.ResourceRequirements{Limits: tc.Limits}},
+				}},
+			}
+			raw, err := json.Marshal(pod)
+			if err != nil {
+				t.Fatalf("No error is expected but got: %v", err)
+			}
+			req := admission.Request{}
+			req.Namespace = "default"
+			req.Object = runtime.RawExtension{Raw: raw}
+
+			resp := a.handleSharedClaimPod(context.TODO(), req, pod, &podTranslation{annotations: pod.Annotations}, tc.ClaimName)
+			if resp.Allowed == tc.ExpectDenied {
+				t.Fatalf("expect denied: %v, but got: %v", tc.ExpectDenied, resp.Result)
+			}
+			if patched := len(resp.Patches) > 0; patched != tc.ExpectPatch {
+				t.Fatalf("expect patch: %v, but got: %v", tc.ExpectPatch, resp.Patches)
+			}
+			if !tc.ExpectPatch {
+				return
+			}
+			if pod.Labels[constants.SharedGPUClaimLabel] != tc.ClaimName {
+				t.Fatalf("expect label %s=%s, but got: %v", constants.SharedGPUClaimLabel, tc.ClaimName, pod.Labels)
+			}
+			if claims := pod.Spec.ResourceClaims; len(claims) != 1 || *claims[0].ResourceClaimName != tc.ClaimName {
+				t.Fatalf("expect the pod to use claim %s, but got: %v", tc.ClaimName, claims)
+			}
+			claims := &resourceapi.ResourceClaimList{}
+			if err := a.Client.List(context.TODO(), claims, client.InNamespace("default")); err != nil {
+				t.Fatalf("No error is expected but got: %v", err)
+			}
+			if len(claims.Items) != 1 {
+				t.Fatalf("expect one shared ResourceClaim, but got: %d", len(claims.Items))
+			}
+		})
+	}
+}
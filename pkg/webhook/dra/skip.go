@@ -0,0 +1,65 @@
+/*
+Copyright 2025 The HAMi Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package dra
+
+import (
+	"context"
+	"fmt"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/types"
+
+	"github.com/Project-HAMi/HAMi-DRA/pkg/constants"
+)
+
+// skipReason returns why an object of the namespace with the given annotations is not translated,
+// or an empty string if it is.
+func (a *MutatingAdmission) skipReason(ctx context.Context, namespace string, annotations ...map[string]string) (string, error) {
+	for _, objAnnotations := range annotations {
+		if objAnnotations[constants.SkipTranslationAnnotation] == "true" {
+			return fmt.Sprintf("GPU resources are not translated: annotation %s is true", constants.SkipTranslationAnnotation), nil
+		}
+	}
+
+	if a.AdmissionConfig == nil || (a.AdmissionConfig.NamespaceSelector == nil && a.AdmissionConfig.ExcludeNamespaceSelector == nil) {
+		return "", nil
+	}
+	ns := &corev1.Namespace{}
+	if err := a.Client.Get(ctx, types.NamespacedName{Name: namespace}, ns); err != nil {
+		return "", fmt.Errorf("failed to get namespace %s: %w", namespace, err)
+	}
+	nsLabels := labels.Set(ns.Labels)
+
+	if a.AdmissionConfig.NamespaceSelector != nil {
+		selector, err := a.AdmissionConfig.NamespaceSelector.Selector()
+		if err != nil {
+			return "", err
+		}
+		if !selector.Matches(nsLabels) {
+			return fmt.Sprintf("GPU resources are not translated: namespace %s does not match the namespaceSelector of the device config", namespace), nil
+		}
+	}
+	excludeSelector, err := a.AdmissionConfig.ExcludeNamespaceSelector.Selector()
+	if err != nil {
+		return "", err
+	}
+	if excludeSelector.Matches(nsLabels) {
+		return fmt.Sprintf("GPU resources are not translated: namespace %s matches the excludeNamespaceSelector of the device config", namespace), nil
+	}
+	return "", nil
+}
@@ -0,0 +1,97 @@
+/*
+Copyright 2025 The HAMi Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package dra
+
+import (
+	"context"
+	"testing"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"github.com/Project-HAMi/HAMi-DRA/pkg/config"
+	"github.com/Project-HAMi/HAMi-DRA/pkg/constants"
+)
+
+func TestSkipReason(t *testing.T) {
+	tests := []struct {
+		Name            string
+		Namespace       string
+		AdmissionConfig *config.AdmissionConfig
+		Annotations     map[string]string
+		ExpectSkip      bool
+	}{
+		{
+			Name:       "no selectors",
+			Namespace:  "legacy",
+			ExpectSkip: false,
+		},
+		{
+			Name:        "skip annotation",
+			Namespace:   "dra",
+			Annotations: map[string]string{constants.SkipTranslationAnnotation: "true"},
+			ExpectSkip:  true,
+		},
+		{
+			Name:      "namespace opted in",
+			Namespace: "dra",
+			AdmissionConfig: &config.AdmissionConfig{
+				NamespaceSelector: &config.LabelSelector{MatchLabels: map[string]string{"hami.io/dra": "enabled"}},
+			},
+			ExpectSkip: false,
+		},
+		{
+			Name:      "namespace not opted in",
+			Namespace: "legacy",
+			AdmissionConfig: &config.AdmissionConfig{
+				NamespaceSelector: &config.LabelSelector{MatchLabels: map[string]string{"hami.io/dra": "enabled"}},
+			},
+			ExpectSkip: true,
+		},
+		{
+			Name:      "namespace opted out",
+			Namespace: "legacy",
+			AdmissionConfig: &config.AdmissionConfig{
+				ExcludeNamespaceSelector: &config.LabelSelector{MatchExpressions: []config.LabelSelectorRequirement{
+					{Key: "hami.io/scheduler", Operator: "Exists"},
+				}},
+			},
+			ExpectSkip: true,
+		},
+	}
+
+	cl := fake.NewClientBuilder().WithObjects(
+		&corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "dra", Labels: map[string]string{"hami.io/dra": "enabled"}}},
+		&corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "legacy", Labels: map[string]string{"hami.io/scheduler": "true"}}},
+	).Build()
+
+	for i := range tests {
+		tc := tests[i]
+
+		t.Run(tc.Name, func(t *testing.T) {
+			a := &MutatingAdmission{Client: cl, AdmissionConfig: tc.AdmissionConfig}
+			reason, err := a.skipReason(context.TODO(), tc.Namespace, tc.Annotations)
+			if err != nil {
+				t.Fatalf("No error is expected but got: %v", err)
+			}
+			if skip := reason != ""; skip != tc.ExpectSkip {
+				t.Fatalf("expect skip: %v, but got reason: %q", tc.ExpectSkip, reason)
+			}
+		})
+	}
+}
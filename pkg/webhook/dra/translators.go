@@ -0,0 +1,55 @@
+/*
+Copyright 2025 The HAMi Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package dra
+
+import (
+	"sync/atomic"
+
+	"github.com/Project-HAMi/HAMi-DRA/pkg/config"
+	"github.com/Project-HAMi/HAMi-DRA/pkg/device"
+
+	// The device translators dispatched by the webhook register themselves when imported.
+	_ "github.com/Project-HAMi/HAMi-DRA/pkg/device/amd"
+	_ "github.com/Project-HAMi/HAMi-DRA/pkg/device/ascend"
+	_ "github.com/Project-HAMi/HAMi-DRA/pkg/device/awsneuron"
+	_ "github.com/Project-HAMi/HAMi-DRA/pkg/device/enflame"
+	_ "github.com/Project-HAMi/HAMi-DRA/pkg/device/iluvatar"
+	_ "github.com/Project-HAMi/HAMi-DRA/pkg/device/kunlun"
+	_ "github.com/Project-HAMi/HAMi-DRA/pkg/device/metax"
+	_ "github.com/Project-HAMi/HAMi-DRA/pkg/device/nvidia"
+	_ "github.com/Project-HAMi/HAMi-DRA/pkg/device/vgpu"
+)
+
+// translatorSet holds the device translators created from a device config.
+type translatorSet struct {
+	config      *config.Config
+	translators []device.DeviceTranslator
+}
+
+// cachedTranslators are the translators of the last device config requests were handled with.
+var cachedTranslators atomic.Pointer[translatorSet]
+
+// translatorsFor returns the device translators of the device config, created once per config.
+// A reloaded config is a new Config, which replaces the cached translators on its first request.
+func translatorsFor(deviceConfig *config.Config) []device.DeviceTranslator {
+	if set := cachedTranslators.Load(); set != nil && set.config == deviceConfig {
+		return set.translators
+	}
+	set := &translatorSet{config: deviceConfig, translators: device.NewTranslators(deviceConfig)}
+	cachedTranslators.Store(set)
+	return set.translators
+}
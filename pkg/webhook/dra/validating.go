@@ -21,28 +21,170 @@ import (
 	"encoding/json"
 	"fmt"
 	"net/http"
+	"strings"
 
-	"github.com/Project-HAMi/HAMi-DRA/pkg/constants"
+	admissionv1 "k8s.io/api/admission/v1"
 	corev1 "k8s.io/api/core/v1"
 	resourceapi "k8s.io/api/resource/v1"
+	apiequality "k8s.io/apimachinery/pkg/api/equality"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/apimachinery/pkg/util/validation/field"
 	"k8s.io/klog/v2"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	"github.com/Project-HAMi/HAMi-DRA/pkg/config"
+	"github.com/Project-HAMi/HAMi-DRA/pkg/constants"
 )
 
 // ValidatingAdmission validates API request when creating/updating/deleting.
 type ValidatingAdmission struct {
-	Decoder admission.Decoder
-	Client  client.Client
+	Decoder      admission.Decoder
+	Client       client.Client
+	DeviceConfig *config.Config
+	// ConfigStore, if set, provides the DeviceConfig of every request so that config reloads take effect.
+	ConfigStore *config.Store
 }
 
 // Check if our ValidatingAdmission implements necessary interface
 var _ admission.Handler = &ValidatingAdmission{}
 
-// This is temporary solution to delete ResourceClaim when Pod is deleted. And it will be replaced in the future.
+// Handle yields a response to an AdmissionRequest.
 func (v *ValidatingAdmission) Handle(ctx context.Context, req admission.Request) admission.Response {
+	if v.ConfigStore != nil {
+		// Handle the request on a copy bound to the current config, a reload never changes it mid-request.
+		snapshot := *v
+		snapshot.DeviceConfig = v.ConfigStore.Load()
+		snapshot.ConfigStore = nil
+		return snapshot.Handle(ctx, req)
+	}
+
+	switch req.Operation {
+	case admissionv1.Update:
+		return v.handleUpdate(req)
+	case admissionv1.Delete:
+		return v.handleDelete(ctx, req)
+	default:
+		return admission.Allowed("")
+	}
+}
+
+// handleUpdate rejects updates that would leave a translated pod inconsistent with its ResourceClaims.
+func (v *ValidatingAdmission) handleUpdate(req admission.Request) admission.Response {
+	oldPod := &corev1.Pod{}
+	if err := json.Unmarshal(req.OldObject.Raw, oldPod); err != nil {
+		return admission.Errored(http.StatusBadRequest, err)
+	}
+	pod := &corev1.Pod{}
+	if err := json.Unmarshal(req.Object.Raw, pod); err != nil {
+		return admission.Errored(http.StatusBadRequest, err)
+	}
+	klog.V(5).Infof("Validating Pod(%s/%s) for request: %s %s", req.Namespace, pod.Name, req.Operation, req.SubResource)
+
+	if errs := v.validatePodUpdate(oldPod, pod); len(errs) > 0 {
+		return admission.Denied(errs.ToAggregate().Error())
+	}
+	return admission.Allowed("")
+}
+
+// validatePodUpdate compares the fields owned by the mutating webhook between the old and new pod.
+func (v *ValidatingAdmission) validatePodUpdate(oldPod, pod *corev1.Pod) field.ErrorList {
+	var errs field.ErrorList
+
+	_, translated := oldPod.Labels[constants.DraLabel]
+	for _, key := range ownedLabels {
+		if oldPod.Labels[key] != pod.Labels[key] {
+			errs = append(errs, field.Forbidden(field.NewPath("metadata", "labels").Key(key),
+				"label is managed by the HAMi DRA webhook and cannot be added, changed or removed"))
+		}
+	}
+	if !translated {
+		return errs
+	}
+
+	keys := sets.New(ownedAnnotations...)
+	for _, annotations := range []map[string]string{oldPod.Annotations, pod.Annotations} {
+		for key := range annotations {
+			if strings.HasPrefix(key, constants.SharedGPUGroupAnnotationPrefix) {
+				keys.Insert(key)
+			}
+		}
+	}
+	for _, key := range sets.List(keys) {
+		if oldPod.Annotations[key] != pod.Annotations[key] {
+			errs = append(errs, field.Forbidden(field.NewPath("metadata", "annotations").Key(key),
+				"annotation was translated into the pod's ResourceClaims and cannot be changed; recreate the pod instead"))
+		}
+	}
+
+	if !apiequality.Semantic.DeepEqual(oldPod.Spec.ResourceClaims, pod.Spec.ResourceClaims) {
+		errs = append(errs, field.Forbidden(field.NewPath("spec", "resourceClaims"),
+			"resourceClaims are generated by the HAMi DRA webhook and cannot be changed"))
+	}
+
+	oldContainers := make(map[string]*corev1.Container, len(oldPod.Spec.Containers))
+	for i := range oldPod.Spec.Containers {
+		oldContainers[oldPod.Spec.Containers[i].Name] = &oldPod.Spec.Containers[i]
+	}
+	for i := range pod.Spec.Containers {
+		container := &pod.Spec.Containers[i]
+		fldPath := field.NewPath("spec", "containers").Index(i).Child("resources")
+		oldContainer, ok := oldContainers[container.Name]
+		if !ok {
+			continue
+		}
+		if !apiequality.Semantic.DeepEqual(oldContainer.Resources.Claims, container.Resources.Claims) {
+			errs = append(errs, field.Forbidden(fldPath.Child("claims"),
+				"claims are generated by the HAMi DRA webhook and cannot be changed"))
+		}
+		for _, name := range v.gpuResourceNames() {
+			if !quantityEqual(oldContainer.Resources.Limits, container.Resources.Limits, name) ||
+				!quantityEqual(oldContainer.Resources.Requests, container.Resources.Requests, name) {
+				errs = append(errs, field.Forbidden(fldPath.Key(string(name)),
+					fmt.Sprintf("in-place changes to GPU resources are not supported, the GPU is held by the pod's ResourceClaims %v; recreate the pod to change it",
+						claimNames(oldContainer))))
+			}
+		}
+	}
+
+	return errs
+}
+
+// gpuResourceNames returns the extended resource names that are translated into ResourceClaims.
+func (v *ValidatingAdmission) gpuResourceNames() []corev1.ResourceName {
+	if v.DeviceConfig == nil {
+		return nil
+	}
+	var names []corev1.ResourceName
+	for _, translator := range translatorsFor(v.DeviceConfig) {
+		names = append(names, translator.ResourceNames()...)
+	}
+	return names
+}
+
+// claimNames returns the names of the claims used by the container.
+func claimNames(container *corev1.Container) []string {
+	names := make([]string, 0, len(container.Resources.Claims))
+	for _, claim := range container.Resources.Claims {
+		names = append(names, claim.Name)
+	}
+	return names
+}
+
+// quantityEqual reports whether the named resource is equal in both resource lists.
+func quantityEqual(oldList, newList corev1.ResourceList, name corev1.ResourceName) bool {
+	oldQty, oldOk := oldList[name]
+	newQty, newOk := newList[name]
+	if oldOk != newOk {
+		return false
+	}
+	return oldQty.Cmp(newQty) == 0
+}
+
+// This is temporary solution to delete ResourceClaim when Pod is deleted. And it will be replaced in the future.
+func (v *ValidatingAdmission) handleDelete(ctx context.Context, req admission.Request) admission.Response {
 	pod := &corev1.Pod{}
 
 	if err := json.Unmarshal(req.OldObject.Raw, pod); err != nil {
@@ -53,15 +195,25 @@ func (v *ValidatingAdmission) Handle(ctx context.Context, req admission.Request)
 	}
 	klog.V(5).Infof("Validating Pod(%s/%s) for request: %s", req.Namespace, pod.Name, req.Operation)
 
+	rcNames := sets.New[string]()
 	for _, container := range pod.Spec.Containers {
+		rcNames.Insert(resourceClaimName(pod, container.Name))
+	}
+	if groups, err := sharedGPUGroups(pod); err == nil {
+		for _, group := range groups {
+			rcNames.Insert(sharedResourceClaimName(pod, group))
+		}
+	}
+
+	for _, rcName := range sets.List(rcNames) {
 		err := v.Client.Delete(ctx, &resourceapi.ResourceClaim{
 			ObjectMeta: metav1.ObjectMeta{
-				Name:      fmt.Sprintf("%s-%s-%s", pod.Namespace, pod.Name, container.Name),
+				Name:      rcName,
 				Namespace: pod.Namespace,
 			},
 		})
 		if err != nil && !apierrors.IsNotFound(err) {
-			klog.Warningf("Failed to delete ResourceClaim %s/%s: %v", pod.Namespace, fmt.Sprintf("%s-%s-%s", pod.Namespace, pod.Name, container.Name), err)
+			klog.Warningf("Failed to delete ResourceClaim %s/%s: %v", pod.Namespace, rcName, err)
 			continue
 		}
 	}
@@ -0,0 +1,142 @@
+/*
+Copyright 2025 The HAMi Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package dra
+
+import (
+	"testing"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/Project-HAMi/HAMi-DRA/pkg/config"
+	"github.com/Project-HAMi/HAMi-DRA/pkg/constants"
+)
+
+func translatedPod() *corev1.Pod {
+	rcName := "default-pod-main"
+	return &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        "pod",
+			Namespace:   "default",
+			Labels:      map[string]string{constants.DraLabel: "true"},
+			Annotations: map[string]string{constants.UseTypeAnnotation: "A100"},
+		},
+		Spec: corev1.PodSpec{
+			Containers: []corev1.Container{
+				{
+					Name: "main",
+					Resources: corev1.ResourceRequirements{
+						Claims: []corev1.ResourceClaim{{Name: rcName}},
+					},
+				},
+			},
+			ResourceClaims: []corev1.PodResourceClaim{
+				{Name: rcName, ResourceClaimName: &rcName},
+			},
+		},
+	}
+}
+
+func TestValidatePodUpdate(t *testing.T) {
+	tests := []struct {
+		Name        string
+		OldPod      *corev1.Pod
+		Mutate      func(pod *corev1.Pod)
+		ExpectError bool
+	}{
+		{
+			Name:        "unrelated label change",
+			OldPod:      translatedPod(),
+			Mutate:      func(pod *corev1.Pod) { pod.Labels["app"] = "demo" },
+			ExpectError: false,
+		},
+		{
+			Name:        "remove dra label",
+			OldPod:      translatedPod(),
+			Mutate:      func(pod *corev1.Pod) { delete(pod.Labels, constants.DraLabel) },
+			ExpectError: true,
+		},
+		{
+			Name: "add dra label to untranslated pod",
+			OldPod: &corev1.Pod{
+				ObjectMeta: metav1.ObjectMeta{Name: "pod", Namespace: "default"},
+			},
+			Mutate: func(pod *corev1.Pod) {
+				pod.Labels = map[string]string{constants.DraLabel: "true"}
+			},
+			ExpectError: true,
+		},
+		{
+			Name:        "change gpu type annotation",
+			OldPod:      translatedPod(),
+			Mutate:      func(pod *corev1.Pod) { pod.Annotations[constants.UseTypeAnnotation] = "H100" },
+			ExpectError: true,
+		},
+		{
+			Name:        "drop container claim",
+			OldPod:      translatedPod(),
+			Mutate:      func(pod *corev1.Pod) { pod.Spec.Containers[0].Resources.Claims = nil },
+			ExpectError: true,
+		},
+		{
+			Name:   "resize gpu memory",
+			OldPod: translatedPod(),
+			Mutate: func(pod *corev1.Pod) {
+				pod.Spec.Containers[0].Resources.Limits = corev1.ResourceList{
+					"nvidia.com/gpumem": resource.MustParse("2000"),
+				}
+			},
+			ExpectError: true,
+		},
+		{
+			Name:   "resize cpu",
+			OldPod: translatedPod(),
+			Mutate: func(pod *corev1.Pod) {
+				pod.Spec.Containers[0].Resources.Limits = corev1.ResourceList{
+					corev1.ResourceCPU: resource.MustParse("2"),
+				}
+			},
+			ExpectError: false,
+		},
+	}
+
+	v := &ValidatingAdmission{
+		DeviceConfig: &config.Config{
+			Nvidia: config.NvidiaConfig{
+				ResourceCountName:  "nvidia.com/gpu",
+				ResourceMemoryName: "nvidia.com/gpumem",
+				ResourceCoreName:   "nvidia.com/gpucores",
+			},
+		},
+	}
+	for i := range tests {
+		tc := tests[i]
+
+		t.Run(tc.Name, func(t *testing.T) {
+			pod := tc.OldPod.DeepCopy()
+			tc.Mutate(pod)
+			errs := v.validatePodUpdate(tc.OldPod, pod)
+			if tc.ExpectError && len(errs) == 0 {
+				t.Fatalf("Expect error, but got nil")
+			}
+			if !tc.ExpectError && len(errs) > 0 {
+				t.Fatalf("No error is expected but got: %v", errs.ToAggregate())
+			}
+		})
+	}
+}
@@ -0,0 +1,223 @@
+/*
+Copyright 2025 The HAMi Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package dra
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"hash/fnv"
+	"net/http"
+	"strings"
+
+	appsv1 "k8s.io/api/apps/v1"
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	resourceapi "k8s.io/api/resource/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/rand"
+	"k8s.io/apimachinery/pkg/util/validation"
+	"k8s.io/klog/v2"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	"github.com/Project-HAMi/HAMi-DRA/pkg/constants"
+)
+
+// handleWorkload translates the GPU resources in a workload's pod template into ResourceClaimTemplates,
+// so that the DRA form is visible on the workload itself and no API write is needed per pod.
+func (a *MutatingAdmission) handleWorkload(ctx context.Context, req admission.Request) admission.Response {
+	obj, template, err := a.decodeWorkload(req)
+	if err != nil {
+		return admission.Errored(http.StatusBadRequest, err)
+	}
+	if template == nil {
+		return admission.Allowed("")
+	}
+	workloadName := obj.(metav1.Object).GetName()
+	if workloadName == "" {
+		workloadName = strings.TrimSuffix(obj.(metav1.Object).GetGenerateName(), "-")
+	}
+
+	klog.V(5).Infof("Mutating %s(%s/%s) for request: %s", req.Kind.Kind, req.Namespace, workloadName, req.Operation)
+	needPatch := false
+	dryRun := req.DryRun != nil && *req.DryRun
+
+	reason, err := a.skipReason(ctx, req.Namespace, obj.(metav1.Object).GetAnnotations(), template.Annotations)
+	if err != nil {
+		return admission.Errored(http.StatusInternalServerError, err)
+	}
+	if reason != "" {
+		klog.V(5).Infof("Skip mutating %s(%s/%s): %s", req.Kind.Kind, req.Namespace, workloadName, reason)
+		return admission.Allowed(reason)
+	}
+
+	// Every pod of a workload uses the claims generated from its templates, which cannot be shared between containers.
+	if key, ok := sharedGPUGroupAnnotation(template.Annotations); ok {
+		return admission.Denied(fmt.Sprintf("annotation %s is only supported on pods, not on %s pod templates", key, req.Kind.Kind))
+	}
+
+	translation, err := a.newPodTranslation(ctx, req.Namespace, &template.ObjectMeta, &template.Spec)
+	if err != nil {
+		return admission.Errored(http.StatusInternalServerError, err)
+	}
+
+	for i := range template.Spec.Containers {
+		container := &template.Spec.Containers[i]
+		spec, err := a.translateContainer(container, translation)
+		if err != nil {
+			return errorResponse(err)
+		}
+		if spec == nil {
+			continue
+		}
+
+		rctName, err := resourceClaimTemplateName(req.Kind.Kind, workloadName, container.Name, spec)
+		if err != nil {
+			return admission.Errored(http.StatusInternalServerError, err)
+		}
+		if !dryRun {
+			if err := a.ensureResourceClaimTemplate(ctx, req, obj.(metav1.Object), workloadName, rctName, spec); err != nil {
+				return admission.Errored(http.StatusInternalServerError, err)
+			}
+		}
+
+		needPatch = true
+		addTemplateResourceClaim(&template.Spec, container, rctName)
+	}
+
+	if !needPatch {
+		klog.V(5).Infof("No need to patch %s(%s/%s) for request: %s", req.Kind.Kind, req.Namespace, workloadName, req.Operation)
+		return admission.Allowed("")
+	}
+
+	marshaledBytes, err := json.Marshal(obj)
+	if err != nil {
+		return admission.Errored(http.StatusInternalServerError, err)
+	}
+	return admission.PatchResponseFromRaw(req.Object.Raw, marshaledBytes)
+}
+
+// decodeWorkload decodes the workload in the request and returns it along with its pod template.
+// The returned template is nil for kinds that are not handled.
+func (a *MutatingAdmission) decodeWorkload(req admission.Request) (runtime.Object, *corev1.PodTemplateSpec, error) {
+	var obj runtime.Object
+	var template *corev1.PodTemplateSpec
+
+	switch req.Kind.Kind {
+	case "Deployment":
+		deployment := &appsv1.Deployment{}
+		obj, template = deployment, &deployment.Spec.Template
+	case "StatefulSet":
+		statefulSet := &appsv1.StatefulSet{}
+		obj, template = statefulSet, &statefulSet.Spec.Template
+	case "DaemonSet":
+		daemonSet := &appsv1.DaemonSet{}
+		obj, template = daemonSet, &daemonSet.Spec.Template
+	case "Job":
+		job := &batchv1.Job{}
+		obj, template = job, &job.Spec.Template
+	case "CronJob":
+		cronJob := &batchv1.CronJob{}
+		obj, template = cronJob, &cronJob.Spec.JobTemplate.Spec.Template
+	default:
+		klog.V(5).Infof("Skip mutating unsupported kind %s", req.Kind.String())
+		return nil, nil, nil
+	}
+
+	if err := a.Decoder.Decode(req, obj); err != nil {
+		return nil, nil, err
+	}
+	return obj, template, nil
+}
+
+// addTemplateResourceClaim makes the container use a claim generated from the named ResourceClaimTemplate.
+// The claim is named after the container, so translating the workload again, such as when a manifest
+// still requesting GPUs is re-applied, points the existing claim to the new template instead of adding another one.
+func addTemplateResourceClaim(podSpec *corev1.PodSpec, container *corev1.Container, rctName string) {
+	addContainerClaim(container, container.Name)
+	for i := range podSpec.ResourceClaims {
+		if claim := &podSpec.ResourceClaims[i]; claim.Name == container.Name {
+			claim.ResourceClaimName = nil
+			claim.ResourceClaimTemplateName = &rctName
+			return
+		}
+	}
+	podSpec.ResourceClaims = append(podSpec.ResourceClaims, corev1.PodResourceClaim{
+		Name:                      container.Name,
+		ResourceClaimTemplateName: &rctName,
+	})
+}
+
+// ensureResourceClaimTemplate creates the ResourceClaimTemplate unless it already exists.
+// Template names are derived from their spec, so an existing template always has the wanted spec.
+// The template is owned by the workload so that it is garbage collected with it. A workload being created
+// has no UID yet, its templates are adopted by the resourceclaimtemplate-cleanup controller.
+func (a *MutatingAdmission) ensureResourceClaimTemplate(ctx context.Context, req admission.Request, workload metav1.Object, workloadName, name string, spec *resourceapi.ResourceClaimSpec) error {
+	rct := &resourceapi.ResourceClaimTemplate{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: req.Namespace,
+			Labels:    map[string]string{constants.DraLabel: "true"},
+			Annotations: map[string]string{
+				constants.WorkloadAnnotation: fmt.Sprintf("%s/%s", req.Kind.Kind, workloadName),
+			},
+		},
+		Spec: resourceapi.ResourceClaimTemplateSpec{
+			Spec: *spec,
+		},
+	}
+	if workload.GetUID() != "" {
+		rct.OwnerReferences = []metav1.OwnerReference{{
+			APIVersion: schema.GroupVersion{Group: req.Kind.Group, Version: req.Kind.Version}.String(),
+			Kind:       req.Kind.Kind,
+			Name:       workload.GetName(),
+			UID:        workload.GetUID(),
+		}}
+	}
+	if err := a.Client.Create(ctx, rct); err != nil {
+		if apierrors.IsAlreadyExists(err) {
+			return nil
+		}
+		return fmt.Errorf("failed to create ResourceClaimTemplate %s/%s: %w", req.Namespace, name, err)
+	}
+
+	klog.V(4).Infof("Successfully created ResourceClaimTemplate %s/%s", req.Namespace, name)
+	return nil
+}
+
+// resourceClaimTemplateName returns a name derived from the workload, the container and the claim spec.
+// The workload kind is part of the hash, workloads of different kinds may have the same name.
+func resourceClaimTemplateName(workloadKind, workloadName, containerName string, spec *resourceapi.ResourceClaimSpec) (string, error) {
+	data, err := json.Marshal(spec)
+	if err != nil {
+		return "", err
+	}
+	hasher := fnv.New32a()
+	_, _ = hasher.Write([]byte(workloadKind))
+	_, _ = hasher.Write(data)
+	hash := rand.SafeEncodeString(fmt.Sprint(hasher.Sum32()))
+
+	prefix := fmt.Sprintf("%s-%s", workloadName, containerName)
+	if maxLen := validation.DNS1123SubdomainMaxLength - len(hash) - 1; len(prefix) > maxLen {
+		// The truncated prefix must still end with an alphanumeric character.
+		prefix = strings.TrimRight(prefix[:maxLen], "-.")
+	}
+	return fmt.Sprintf("%s-%s", prefix, hash), nil
+}
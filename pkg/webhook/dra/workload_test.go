@@ -0,0 +1,365 @@
+/*
+Copyright 2025 The HAMi Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package dra
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+	"testing"
+
+	jsonpatch "github.com/evanphx/json-patch/v5"
+	admissionv1 "k8s.io/api/admission/v1"
+	appsv1 "k8s.io/api/apps/v1"
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	resourceapi "k8s.io/api/resource/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/validation"
+	"k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	policyv1alpha1 "github.com/Project-HAMi/HAMi-DRA/pkg/apis/policy/v1alpha1"
+	"github.com/Project-HAMi/HAMi-DRA/pkg/config"
+	"github.com/Project-HAMi/HAMi-DRA/pkg/constants"
+)
+
+// gpuPodTemplate returns a pod template whose main container requests one GPU.
+func gpuPodTemplate() corev1.PodTemplateSpec {
+	return corev1.PodTemplateSpec{
+		Spec: corev1.PodSpec{
+			Containers: []corev1.Container{{
+				Name: "main",
+				Resources: corev1.ResourceRequirements{
+					Limits: corev1.ResourceList{"nvidia.com/gpu": resource.MustParse("1")},
+				},
+			}},
+		},
+	}
+}
+
+func gpuDeployment() *appsv1.Deployment {
+	return &appsv1.Deployment{
+		TypeMeta:   metav1.TypeMeta{APIVersion: "apps/v1", Kind: "Deployment"},
+		ObjectMeta: metav1.ObjectMeta{Name: "web", Namespace: "default"},
+		Spec:       appsv1.DeploymentSpec{Template: gpuPodTemplate()},
+	}
+}
+
+// workloadRequest returns an admission request for the workload in the default namespace.
+func workloadRequest(t *testing.T, obj runtime.Object, operation admissionv1.Operation) admission.Request {
+	raw, err := json.Marshal(obj)
+	if err != nil {
+		t.Fatalf("No error is expected but got: %v", err)
+	}
+	gvk := obj.GetObjectKind().GroupVersionKind()
+	return admission.Request{AdmissionRequest: admissionv1.AdmissionRequest{
+		Kind:      metav1.GroupVersionKind{Group: gvk.Group, Version: gvk.Version, Kind: gvk.Kind},
+		Namespace: "default",
+		Operation: operation,
+		Object:    runtime.RawExtension{Raw: raw},
+	}}
+}
+
+// patchedPodSpec applies the response patch to the request object and returns the pod template spec at path.
+func patchedPodSpec(t *testing.T, req admission.Request, resp admission.Response, path ...string) corev1.PodSpec {
+	raw := req.Object.Raw
+	if len(resp.Patches) > 0 {
+		patchBytes, err := json.Marshal(resp.Patches)
+		if err != nil {
+			t.Fatalf("No error is expected but got: %v", err)
+		}
+		patch, err := jsonpatch.DecodePatch(patchBytes)
+		if err != nil {
+			t.Fatalf("No error is expected but got: %v", err)
+		}
+		if raw, err = patch.Apply(raw); err != nil {
+			t.Fatalf("No error is expected but got: %v", err)
+		}
+	}
+	obj := map[string]any{}
+	if err := json.Unmarshal(raw, &obj); err != nil {
+		t.Fatalf("No error is expected but got: %v", err)
+	}
+	for _, key := range append(path, "template", "spec") {
+		obj, _ = obj[key].(map[string]any)
+	}
+	data, _ := json.Marshal(obj)
+	podSpec := corev1.PodSpec{}
+	if err := json.Unmarshal(data, &podSpec); err != nil {
+		t.Fatalf("No error is expected but got: %v", err)
+	}
+	return podSpec
+}
+
+func TestHandleWorkload(t *testing.T) {
+	reapplied := gpuDeployment()
+	reapplied.Spec.Template.Spec.ResourceClaims = []corev1.PodResourceClaim{{Name: "main", ResourceClaimTemplateName: ptr.To("web-main-old")}}
+	reapplied.Spec.Template.Spec.Containers[0].Resources.Claims = []corev1.ResourceClaim{{Name: "main"}}
+
+	userClaim := gpuDeployment()
+	userClaim.Spec.Template.Spec.ResourceClaims = []corev1.PodResourceClaim{{Name: "nic", ResourceClaimTemplateName: ptr.To("rdma")}}
+	userClaim.Spec.Template.Spec.Containers[0].Resources.Claims = []corev1.ResourceClaim{{Name: "nic"}}
+
+	updated := gpuDeployment()
+	updated.UID = "deployment-uid"
+
+	grouped := gpuDeployment()
+	grouped.Spec.Template.Annotations = map[string]string{"shared-gpu-group.hami.io/infer": "main"}
+
+	cronJob := &batchv1.CronJob{
+		TypeMeta:   metav1.TypeMeta{APIVersion: "batch/v1", Kind: "CronJob"},
+		ObjectMeta: metav1.ObjectMeta{Name: "nightly", Namespace: "default"},
+	}
+	cronJob.Spec.JobTemplate.Spec.Template = gpuPodTemplate()
+
+	tests := []struct {
+		Name            string
+		Object          runtime.Object
+		Operation       admissionv1.Operation
+		DryRun          bool
+		TemplatePath    []string
+		ExpectDenied    bool
+		ExpectPatch     bool
+		ExpectClaims    []string
+		ExpectTemplates int
+		ExpectOwner     types.UID
+	}{
+		{
+			Name:            "deployment",
+			Object:          gpuDeployment(),
+			TemplatePath:    []string{"spec"},
+			ExpectPatch:     true,
+			ExpectClaims:    []string{"main"},
+			ExpectTemplates: 1,
+		},
+		{
+			Name: "statefulset",
+			Object: &appsv1.StatefulSet{
+				TypeMeta:   metav1.TypeMeta{APIVersion: "apps/v1", Kind: "StatefulSet"},
+				ObjectMeta: metav1.ObjectMeta{Name: "db", Namespace: "default"},
+				Spec:       appsv1.StatefulSetSpec{Template: gpuPodTemplate()},
+			},
+			TemplatePath:    []string{"spec"},
+			ExpectPatch:     true,
+			ExpectClaims:    []string{"main"},
+			ExpectTemplates: 1,
+		},
+		{
+			Name: "daemonset",
+			Object: &appsv1.DaemonSet{
+				TypeMeta:   metav1.TypeMeta{APIVersion: "apps/v1", Kind: "DaemonSet"},
+				ObjectMeta: metav1.ObjectMeta{Name: "agent", Namespace: "default"},
+				Spec:       appsv1.DaemonSetSpec{Template: gpuPodTemplate()},
+			},
+			TemplatePath:    []string{"spec"},
+			ExpectPatch:     true,
+			ExpectClaims:    []string{"main"},
+			ExpectTemplates: 1,
+		},
+		{
+			Name: "job with generated name",
+			Object: &batchv1.Job{
+				TypeMeta:   metav1.TypeMeta{APIVersion: "batch/v1", Kind: "Job"},
+				ObjectMeta: metav1.ObjectMeta{GenerateName: "train-", Namespace: "default"},
+				Spec:       batchv1.JobSpec{Template: gpuPodTemplate()},
+			},
+			TemplatePath:    []string{"spec"},
+			ExpectPatch:     true,
+			ExpectClaims:    []string{"main"},
+			ExpectTemplates: 1,
+		},
+		{
+			Name:            "cronjob",
+			Object:          cronJob,
+			TemplatePath:    []string{"spec", "jobTemplate", "spec"},
+			ExpectPatch:     true,
+			ExpectClaims:    []string{"main"},
+			ExpectTemplates: 1,
+		},
+		{
+			Name: "no gpu",
+			Object: &appsv1.Deployment{
+				TypeMeta:   metav1.TypeMeta{APIVersion: "apps/v1", Kind: "Deployment"},
+				ObjectMeta: metav1.ObjectMeta{Name: "web", Namespace: "default"},
+				Spec: appsv1.DeploymentSpec{Template: corev1.PodTemplateSpec{
+					Spec: corev1.PodSpec{Containers: []corev1.Container{{Name: "main"}}},
+				}},
+			},
+			TemplatePath: []string{"spec"},
+		},
+		{
+			Name:         "dry run",
+			Object:       gpuDeployment(),
+			DryRun:       true,
+			TemplatePath: []string{"spec"},
+			ExpectPatch:  true,
+			ExpectClaims: []string{"main"},
+		},
+		{
+			Name:            "re-applied manifest",
+			Object:          reapplied,
+			TemplatePath:    []string{"spec"},
+			ExpectPatch:     true,
+			ExpectClaims:    []string{"main"},
+			ExpectTemplates: 1,
+		},
+		{
+			Name:            "user claims kept",
+			Object:          userClaim,
+			TemplatePath:    []string{"spec"},
+			ExpectPatch:     true,
+			ExpectClaims:    []string{"nic", "main"},
+			ExpectTemplates: 1,
+		},
+		{
+			Name:            "owned by updated workload",
+			Object:          updated,
+			Operation:       admissionv1.Update,
+			TemplatePath:    []string{"spec"},
+			ExpectPatch:     true,
+			ExpectClaims:    []string{"main"},
+			ExpectTemplates: 1,
+			ExpectOwner:     "deployment-uid",
+		},
+		{
+			Name:         "shared gpu group",
+			Object:       grouped,
+			TemplatePath: []string{"spec"},
+			ExpectDenied: true,
+		},
+	}
+
+	sch := runtime.NewScheme()
+	_ = scheme.AddToScheme(sch)
+	_ = policyv1alpha1.AddToScheme(sch)
+
+	for i := range tests {
+		tc := tests[i]
+
+		t.Run(tc.Name, func(t *testing.T) {
+			cl := fake.NewClientBuilder().WithScheme(sch).Build()
+			a := &MutatingAdmission{
+				Decoder:      admission.NewDecoder(sch),
+				Client:       cl,
+				DeviceConfig: nvidiaConfig(config.NvidiaConfig{}),
+			}
+			operation := tc.Operation
+			if operation == "" {
+				operation = admissionv1.Create
+			}
+			req := workloadRequest(t, tc.Object, operation)
+			req.DryRun = ptr.To(tc.DryRun)
+
+			resp := a.Handle(context.TODO(), req)
+			if tc.ExpectDenied {
+				if resp.Allowed {
+					t.Fatalf("Expect error, but got nil")
+				}
+				return
+			}
+			if !resp.Allowed {
+				t.Fatalf("No error is expected but got: %v", resp.Result)
+			}
+			if patched := len(resp.Patches) > 0; patched != tc.ExpectPatch {
+				t.Fatalf("expect patch: %v, but got: %v", tc.ExpectPatch, resp.Patches)
+			}
+
+			rcts := &resourceapi.ResourceClaimTemplateList{}
+			if err := cl.List(context.TODO(), rcts, client.InNamespace("default")); err != nil {
+				t.Fatalf("No error is expected but got: %v", err)
+			}
+			if len(rcts.Items) != tc.ExpectTemplates {
+				t.Fatalf("expect %d ResourceClaimTemplates, but got: %d", tc.ExpectTemplates, len(rcts.Items))
+			}
+
+			podSpec := patchedPodSpec(t, req, resp, tc.TemplatePath...)
+			var claims []string
+			for _, claim := range podSpec.Containers[0].Resources.Claims {
+				claims = append(claims, claim.Name)
+			}
+			if strings.Join(claims, ",") != strings.Join(tc.ExpectClaims, ",") {
+				t.Fatalf("expect container claims: %v, but got: %v", tc.ExpectClaims, claims)
+			}
+			if len(podSpec.ResourceClaims) != len(tc.ExpectClaims) {
+				t.Fatalf("expect pod claims: %v, but got: %v", tc.ExpectClaims, podSpec.ResourceClaims)
+			}
+			for _, claim := range podSpec.ResourceClaims {
+				if claim.Name != "main" {
+					continue
+				}
+				// The claim of the container points to the template created for it.
+				if len(rcts.Items) > 0 && *claim.ResourceClaimTemplateName != rcts.Items[0].Name {
+					t.Fatalf("expect template %s, but got: %s", rcts.Items[0].Name, *claim.ResourceClaimTemplateName)
+				}
+				if !strings.Contains(*claim.ResourceClaimTemplateName, "-main-") {
+					t.Fatalf("expect a template named after the container, but got: %s", *claim.ResourceClaimTemplateName)
+				}
+			}
+
+			if tc.ExpectTemplates == 0 {
+				return
+			}
+			rct := rcts.Items[0]
+			if rct.Labels[constants.DraLabel] != "true" {
+				t.Fatalf("expect label %s on the template, but got: %v", constants.DraLabel, rct.Labels)
+			}
+			var owner types.UID
+			if len(rct.OwnerReferences) > 0 {
+				owner = rct.OwnerReferences[0].UID
+			}
+			if owner != tc.ExpectOwner {
+				t.Fatalf("expect owner: %q, but got: %v", tc.ExpectOwner, rct.OwnerReferences)
+			}
+		})
+	}
+}
+
+func TestResourceClaimTemplateName(t *testing.T) {
+	spec := &resourceapi.ResourceClaimSpec{Devices: resourceapi.DeviceClaim{Requests: []resourceapi.DeviceRequest{{Name: "gpu"}}}}
+	other := &resourceapi.ResourceClaimSpec{Devices: resourceapi.DeviceClaim{Requests: []resourceapi.DeviceRequest{{Name: "gpu0"}}}}
+
+	name, err := resourceClaimTemplateName("Deployment", "web", "main", spec)
+	if err != nil {
+		t.Fatalf("No error is expected but got: %v", err)
+	}
+	if !strings.HasPrefix(name, "web-main-") {
+		t.Fatalf("expect a name prefixed with web-main-, but got: %s", name)
+	}
+	if again, _ := resourceClaimTemplateName("Deployment", "web", "main", spec); again != name {
+		t.Fatalf("expect the same name for the same spec, but got: %s and %s", name, again)
+	}
+	if changed, _ := resourceClaimTemplateName("Deployment", "web", "main", other); changed == name {
+		t.Fatalf("expect another name for another spec, but got: %s", changed)
+	}
+	if statefulSet, _ := resourceClaimTemplateName("StatefulSet", "web", "main", spec); statefulSet == name {
+		t.Fatalf("expect another name for another workload kind, but got: %s", statefulSet)
+	}
+	// Truncating the prefix must not leave it ending with a dash or a dot.
+	for _, workloadName := range []string{strings.Repeat("w", 300), strings.Repeat("w.", 150), strings.Repeat("w-", 150)} {
+		long, _ := resourceClaimTemplateName("Deployment", workloadName, "main", spec)
+		if errs := validation.IsDNS1123Subdomain(long); len(errs) > 0 {
+			t.Fatalf("expect a valid name, but got %s: %v", long, errs)
+		}
+	}
+}